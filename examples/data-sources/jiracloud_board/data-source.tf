@@ -0,0 +1,8 @@
+data "jiracloud_board" "team" {
+  name    = "MYPROJ board"
+  project = "MYPROJ"
+}
+
+output "team_board_id" {
+  value = data.jiracloud_board.team.id
+}
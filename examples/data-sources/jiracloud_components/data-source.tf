@@ -0,0 +1,17 @@
+data "jiracloud_components" "myproj" {
+  project = "MYPROJ"
+}
+
+output "component_leads" {
+  value = { for component in data.jiracloud_components.myproj.components : component.name => component.lead_account_id }
+}
+
+# Only the components led by a given person
+data "jiracloud_user" "jane" {
+  email = "jane.doe@example.com"
+}
+
+data "jiracloud_components" "led_by_jane" {
+  project = "MYPROJ"
+  lead    = data.jiracloud_user.jane.account_id
+}
@@ -0,0 +1,8 @@
+data "jiracloud_issue_type" "story" {
+  project = "ABC"
+  name    = "Story"
+}
+
+output "story_issue_type_id" {
+  value = data.jiracloud_issue_type.story.id
+}
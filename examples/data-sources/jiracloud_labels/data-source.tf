@@ -0,0 +1,9 @@
+data "jiracloud_labels" "all" {}
+
+data "jiracloud_labels" "releases" {
+  prefix = "release-"
+}
+
+output "has_release_label" {
+  value = contains(data.jiracloud_labels.all.labels, "release")
+}
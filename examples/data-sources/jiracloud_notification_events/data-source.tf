@@ -0,0 +1,5 @@
+data "jiracloud_notification_events" "all" {}
+
+output "issue_created_event_id" {
+  value = one([for event in data.jiracloud_notification_events.all.events : event.id if event.name == "Issue Created"])
+}
@@ -0,0 +1,11 @@
+# All the priorities of the instance
+data "jiracloud_priorities" "all" {}
+
+# A single priority, looked up by name
+data "jiracloud_priorities" "high" {
+  name = "High"
+}
+
+output "high_priority_id" {
+  value = data.jiracloud_priorities.high.id
+}
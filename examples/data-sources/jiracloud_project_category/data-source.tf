@@ -0,0 +1,7 @@
+data "jiracloud_project_category" "platform" {
+  name = "Platform"
+}
+
+output "platform_category_id" {
+  value = data.jiracloud_project_category.platform.id
+}
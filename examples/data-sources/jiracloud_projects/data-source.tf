@@ -0,0 +1,7 @@
+data "jiracloud_projects" "software" {
+  type_key = "software"
+}
+
+output "software_project_keys" {
+  value = data.jiracloud_projects.software.projects[*].key
+}
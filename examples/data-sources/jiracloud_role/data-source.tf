@@ -0,0 +1,8 @@
+data "jiracloud_role" "developers" {
+  project = "MYPROJ"
+  name    = "Developers"
+}
+
+output "developers_role_id" {
+  value = data.jiracloud_role.developers.id
+}
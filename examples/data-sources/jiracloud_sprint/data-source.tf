@@ -0,0 +1,20 @@
+data "jiracloud_board" "team" {
+  name    = "MYPROJ board"
+  project = "MYPROJ"
+}
+
+# The active sprint of the board
+data "jiracloud_sprint" "current" {
+  board_id = data.jiracloud_board.team.id
+  state    = "active"
+}
+
+# A sprint looked up by name
+data "jiracloud_sprint" "release" {
+  board_id = data.jiracloud_board.team.id
+  name     = "Release 1.2"
+}
+
+output "current_sprint" {
+  value = "${data.jiracloud_sprint.current.name} ends on ${data.jiracloud_sprint.current.end_date}"
+}
@@ -0,0 +1,9 @@
+data "jiracloud_user" "alice" {
+  email = "alice@example.com"
+}
+
+resource "jiracloud_component" "backend" {
+  project = "MYPROJ"
+  name    = "Backend"
+  lead    = data.jiracloud_user.alice.account_id
+}
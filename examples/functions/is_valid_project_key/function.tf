@@ -0,0 +1,8 @@
+variable "project_key" {
+  type = string
+
+  validation {
+    condition     = provider::jiracloud::is_valid_project_key(var.project_key)
+    error_message = "The project key must be 2 to 10 uppercase letters and digits, starting with a letter."
+  }
+}
@@ -0,0 +1,8 @@
+output "release_ticket_url" {
+  value = provider::jiracloud::issue_url("example.atlassian.net", "PROJ-123")
+}
+
+# The host is taken from the JIRA_URL environment variable when null
+output "issue_urls" {
+  value = [for issue in jiracloud_issue.tasks : provider::jiracloud::issue_url(null, issue.key)]
+}
@@ -0,0 +1,12 @@
+variable "team_short_name" {
+  type    = string
+  default = "plat"
+}
+
+resource "jiracloud_project" "platform" {
+  key                  = provider::jiracloud::normalize_project_key(var.team_short_name)
+  name                 = "Platform"
+  lead_account_id      = "1a2b3c4d5e6f"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+}
@@ -0,0 +1 @@
+terraform import jiracloud_board_estimation.team_board 42
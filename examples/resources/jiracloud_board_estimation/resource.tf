@@ -0,0 +1,4 @@
+resource "jiracloud_board_estimation" "team_board" {
+  board_id = 42
+  field_id = "customfield_10016"
+}
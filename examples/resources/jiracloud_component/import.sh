@@ -0,0 +1,8 @@
+# Components can be imported by project key and component name
+terraform import jiracloud_component.backend MYPROJ:Backend
+
+# or by project key and component ID
+terraform import jiracloud_component.backend MYPROJ:10001
+
+# or by component ID alone
+terraform import jiracloud_component.backend 10001
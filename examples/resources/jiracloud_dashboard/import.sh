@@ -0,0 +1 @@
+terraform import jiracloud_dashboard.backend_team 10042
@@ -0,0 +1,15 @@
+resource "jiracloud_dashboard" "backend_team" {
+  name        = "Backend team"
+  description = "What the backend team is working on."
+
+  share_permissions = [
+    {
+      type      = "project"
+      target_id = "10000"
+    },
+    {
+      type      = "group"
+      target_id = "276f955c-63d7-42c8-9520-92d01dca0625"
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import jiracloud_filter.backend_open_bugs 10042
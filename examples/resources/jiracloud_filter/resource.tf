@@ -0,0 +1,13 @@
+resource "jiracloud_filter" "backend_open_bugs" {
+  name        = "Backend open bugs"
+  description = "Bugs of the backend team that are not done yet."
+  jql         = "project = ABC AND issuetype = Bug AND statusCategory != Done ORDER BY priority DESC"
+  favourite   = true
+
+  share_permissions = [
+    {
+      type      = "project"
+      target_id = "10000"
+    },
+  ]
+}
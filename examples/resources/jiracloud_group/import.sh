@@ -0,0 +1 @@
+terraform import jiracloud_group.release_managers 276f955c-63d7-42c8-9520-92d01dca0625
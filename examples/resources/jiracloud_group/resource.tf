@@ -0,0 +1,3 @@
+resource "jiracloud_group" "release_managers" {
+  name = "release-managers"
+}
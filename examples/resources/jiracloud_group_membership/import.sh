@@ -0,0 +1,2 @@
+# Group memberships can be imported by group ID and account ID
+terraform import jiracloud_group_membership.jane 276f955c-63d7-42c8-9520-92d01dca0625:5b10ac8d82e05b22cc7d4ef5
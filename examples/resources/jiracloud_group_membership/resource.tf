@@ -0,0 +1,8 @@
+resource "jiracloud_group" "release_managers" {
+  name = "release-managers"
+}
+
+resource "jiracloud_group_membership" "jane" {
+  group_id   = jiracloud_group.release_managers.group_id
+  account_id = "5b10ac8d82e05b22cc7d4ef5"
+}
@@ -0,0 +1 @@
+terraform import jiracloud_issue.release_checklist ABC-42
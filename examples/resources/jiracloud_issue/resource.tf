@@ -0,0 +1,16 @@
+resource "jiracloud_issue" "release_checklist" {
+  project     = "ABC"
+  issue_type  = "Task"
+  summary     = "Release 1.0.0 checklist"
+  description = "Go through the release checklist before tagging 1.0.0."
+  labels      = ["release", "checklist"]
+  components  = ["Backend"]
+  priority    = "High"
+}
+
+resource "jiracloud_issue" "release_notes" {
+  project    = "ABC"
+  issue_type = "Sub-task"
+  summary    = "Write the release notes"
+  parent_key = jiracloud_issue.release_checklist.key
+}
@@ -0,0 +1,5 @@
+resource "jiracloud_issue_rank" "login_first" {
+  issue_key           = "MYPROJ-42"
+  reference_issue_key = "MYPROJ-17"
+  position            = "before"
+}
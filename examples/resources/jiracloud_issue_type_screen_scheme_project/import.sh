@@ -0,0 +1 @@
+terraform import jiracloud_issue_type_screen_scheme_project.abc 10000
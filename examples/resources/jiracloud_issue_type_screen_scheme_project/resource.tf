@@ -0,0 +1,4 @@
+resource "jiracloud_issue_type_screen_scheme_project" "abc" {
+  project_id = "10000"
+  scheme_id  = "10001"
+}
@@ -0,0 +1 @@
+terraform import jiracloud_project.abc ABC
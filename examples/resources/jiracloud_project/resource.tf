@@ -0,0 +1,14 @@
+resource "jiracloud_project_category" "letters" {
+  name = "Letters"
+}
+
+resource "jiracloud_project" "abc" {
+  key                  = "ABC"
+  name                 = "Alphabet"
+  description          = "Everything about the alphabet"
+  lead_account_id      = "1a2b3c4d5e6f"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  assignee_type        = "UNASSIGNED"
+  category_id          = jiracloud_project_category.letters.id
+}
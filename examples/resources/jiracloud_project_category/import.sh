@@ -0,0 +1 @@
+terraform import jiracloud_project_category.platform 10000
@@ -0,0 +1,4 @@
+resource "jiracloud_project_category" "platform" {
+  name        = "Platform"
+  description = "Projects of the platform teams"
+}
@@ -0,0 +1,17 @@
+data "jiracloud_role" "developers" {
+  project = "MYPROJ"
+  name    = "Developers"
+}
+
+resource "jiracloud_project_role_actor" "developers" {
+  project = "MYPROJ"
+  role_id = data.jiracloud_role.developers.id
+
+  account_ids = [
+    "5b10ac8d82e05b22cc7d4ef5",
+  ]
+
+  group_ids = [
+    "276f955c-63d7-42c8-9520-92d01dca0625",
+  ]
+}
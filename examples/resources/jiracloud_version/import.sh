@@ -0,0 +1 @@
+terraform import jiracloud_version.release_1_0 10042
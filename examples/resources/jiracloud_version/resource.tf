@@ -0,0 +1,8 @@
+resource "jiracloud_version" "release_1_0" {
+  project_key  = "ABC"
+  name         = "1.0.0"
+  description  = "First stable release"
+  start_date   = "2024-01-08"
+  release_date = "2024-02-02"
+  released     = true
+}
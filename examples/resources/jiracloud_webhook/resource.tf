@@ -0,0 +1,10 @@
+provider "jiracloud" {
+  # Dynamic webhooks can only be registered by OAuth 2.0 and Connect apps.
+  auth_method = "bearer"
+}
+
+resource "jiracloud_webhook" "new_bugs" {
+  url        = "https://automation.example.com/jira/new-bugs"
+  jql_filter = "project = ABC AND issuetype = Bug"
+  events     = ["jira:issue_created", "jira:issue_updated"]
+}
@@ -0,0 +1,177 @@
+// Package adf converts between plain text and the Atlassian Document Format (ADF),
+// the JSON representation of rich text used by the version 3 of the Jira API.
+//
+// Only a lightweight markdown subset is supported: paragraphs separated by blank lines,
+// bullet lists whose items start with "- " or "* ", and `inline code`.
+// Anything richer that is read back from Jira is flattened to its text.
+package adf
+
+import (
+	"strings"
+)
+
+// Node is a node of an ADF document, the document itself included.
+type Node struct {
+	Type    string                 `json:"type"`
+	Version int                    `json:"version,omitempty"`
+	Content []*Node                `json:"content,omitempty"`
+	Text    string                 `json:"text,omitempty"`
+	Marks   []*Mark                `json:"marks,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// Mark is a formatting applied to a text node, e.g. code.
+type Mark struct {
+	Type string `json:"type"`
+}
+
+// FromText converts a plain text, possibly using the lightweight markdown subset, into an ADF document.
+func FromText(text string) *Node {
+	doc := &Node{Type: "doc", Version: 1, Content: []*Node{}}
+
+	var paragraph, list *Node
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			paragraph, list = nil, nil
+			continue
+		}
+
+		if item, isItem := listItemText(line); isItem {
+			paragraph = nil
+			if list == nil {
+				list = &Node{Type: "bulletList"}
+				doc.Content = append(doc.Content, list)
+			}
+
+			list.Content = append(list.Content, &Node{
+				Type:    "listItem",
+				Content: []*Node{{Type: "paragraph", Content: inlineNodes(item)}},
+			})
+			continue
+		}
+
+		list = nil
+		if paragraph == nil {
+			paragraph = &Node{Type: "paragraph"}
+			doc.Content = append(doc.Content, paragraph)
+		} else {
+			paragraph.Content = append(paragraph.Content, &Node{Type: "hardBreak"})
+		}
+
+		paragraph.Content = append(paragraph.Content, inlineNodes(line)...)
+	}
+
+	return doc
+}
+
+// ToText flattens an ADF document back to plain text.
+// Documents built by FromText are converted back to the text they were built from,
+// as long as paragraphs and lists were separated by blank lines.
+func ToText(doc *Node) string {
+	if doc == nil {
+		return ""
+	}
+
+	blocks := make([]string, 0, len(doc.Content))
+	for _, node := range doc.Content {
+		blocks = append(blocks, blockText(node))
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
+
+// Equivalent reports whether the document has the same content as the text, as far as the lightweight markdown subset goes.
+// It ignores the differences ToText can't preserve, e.g. trailing newlines, runs of blank lines or "* " bullets,
+// so that a text read back from Jira can be told apart from a text that really changed.
+func Equivalent(text string, doc *Node) bool {
+	return ToText(FromText(text)) == ToText(doc)
+}
+
+// blockText returns the text of a top level node.
+func blockText(node *Node) string {
+	switch node.Type {
+	case "bulletList":
+		items := make([]string, 0, len(node.Content))
+		for _, item := range node.Content {
+			items = append(items, "- "+childrenText(item, " "))
+		}
+
+		return strings.Join(items, "\n")
+	default:
+		return childrenText(node, "\n")
+	}
+}
+
+// childrenText concatenates the text of the children of the node, separating nested blocks with separator.
+func childrenText(node *Node, separator string) string {
+	var text strings.Builder
+	for i, child := range node.Content {
+		switch child.Type {
+		case "text":
+			if hasMark(child, "code") {
+				text.WriteString("`" + child.Text + "`")
+			} else {
+				text.WriteString(child.Text)
+			}
+		case "hardBreak":
+			text.WriteString("\n")
+		default:
+			if i > 0 && len(child.Content) > 0 && text.Len() > 0 {
+				text.WriteString(separator)
+			}
+			text.WriteString(childrenText(child, separator))
+		}
+	}
+
+	return text.String()
+}
+
+// listItemText reports whether the line is a bullet list item and returns the text of the item.
+func listItemText(line string) (string, bool) {
+	trimmed := strings.TrimLeft(line, " \t")
+	for _, bullet := range []string{"- ", "* "} {
+		if strings.HasPrefix(trimmed, bullet) {
+			return strings.TrimPrefix(trimmed, bullet), true
+		}
+	}
+
+	return "", false
+}
+
+// inlineNodes splits a line into text nodes, marking the parts enclosed in backticks as code.
+// An unmatched backtick is kept as is.
+func inlineNodes(line string) []*Node {
+	var nodes []*Node
+
+	for line != "" {
+		start := strings.Index(line, "`")
+		end := -1
+		if start >= 0 {
+			end = strings.Index(line[start+1:], "`")
+		}
+
+		if start < 0 || end <= 0 {
+			nodes = append(nodes, &Node{Type: "text", Text: line})
+			break
+		}
+
+		if start > 0 {
+			nodes = append(nodes, &Node{Type: "text", Text: line[:start]})
+		}
+
+		nodes = append(nodes, &Node{Type: "text", Text: line[start+1 : start+1+end], Marks: []*Mark{{Type: "code"}}})
+		line = line[start+1+end+1:]
+	}
+
+	return nodes
+}
+
+func hasMark(node *Node, markType string) bool {
+	for _, mark := range node.Marks {
+		if mark.Type == markType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := map[string]string{
+		"single paragraph":       "Hello, world!",
+		"paragraphs":             "First paragraph.\n\nSecond paragraph.",
+		"hard break":             "First line\nsecond line of the same paragraph.",
+		"bullet list":            "- first\n- second\n- third",
+		"paragraph and list":     "Steps:\n\n- build\n- release",
+		"list and paragraph":     "- build\n- release\n\nThat's it.",
+		"inline code":            "Run `terraform apply` to release.",
+		"inline code in a list":  "- run `make`\n- run `make install`",
+		"several inline code":    "`a` and `b`",
+		"unmatched backtick":     "A lone ` backtick",
+		"empty code span kept":   "Two `` backticks",
+		"code spanning the line": "`terraform plan`",
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			doc := FromText(text)
+
+			// The document must survive the JSON encoding Jira receives and sends it back in.
+			encoded, err := json.Marshal(doc)
+			if err != nil {
+				t.Fatalf("encoding the document: %v", err)
+			}
+			decoded := new(Node)
+			if err := json.Unmarshal(encoded, decoded); err != nil {
+				t.Fatalf("decoding the document: %v", err)
+			}
+
+			if got := ToText(decoded); got != text {
+				t.Errorf("expected the text to round-trip\nwant: %q\ngot:  %q\ndocument: %s", text, got, encoded)
+			}
+			if !Equivalent(text, decoded) {
+				t.Errorf("expected the text to be equivalent to its document: %s", encoded)
+			}
+		})
+	}
+}
+
+func TestFromText(t *testing.T) {
+	doc := FromText("Intro with `code`\n\n* one\n* two")
+
+	if doc.Type != "doc" || doc.Version != 1 || len(doc.Content) != 2 {
+		t.Fatalf("expected a version 1 document of two blocks, got: %+v", doc)
+	}
+
+	paragraph := doc.Content[0]
+	if paragraph.Type != "paragraph" || len(paragraph.Content) != 2 {
+		t.Fatalf("expected a paragraph of two text nodes, got: %+v", paragraph)
+	}
+	if code := paragraph.Content[1]; code.Text != "code" || !hasMark(code, "code") {
+		t.Errorf("expected the second text node to be marked as code, got: %+v", code)
+	}
+
+	list := doc.Content[1]
+	if list.Type != "bulletList" || len(list.Content) != 2 {
+		t.Fatalf("expected a bullet list of two items, got: %+v", list)
+	}
+	for i, want := range []string{"one", "two"} {
+		item := list.Content[i]
+		if item.Type != "listItem" || item.Content[0].Type != "paragraph" || item.Content[0].Content[0].Text != want {
+			t.Errorf("expected item %d to be a paragraph of %q, got: %+v", i, want, item)
+		}
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		jira       string
+		equivalent bool
+	}{
+		{"identical", "Hello", "Hello", true},
+		{"trailing newline", "Hello\n", "Hello", true},
+		{"star bullets", "* one\n* two", "- one\n- two", true},
+		{"runs of blank lines", "First\n\n\n\nSecond", "First\n\nSecond", true},
+		{"list right after a paragraph", "Steps:\n- build", "Steps:\n\n- build", true},
+		{"windows line endings", "First\r\n\r\nSecond", "First\n\nSecond", true},
+		{"changed text", "Hello", "Goodbye", false},
+		{"code mark lost", "Run `make`", "Run make", false},
+		{"paragraph turned into a list", "one", "- one", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Equivalent(test.text, FromText(test.jira)); got != test.equivalent {
+				t.Errorf("expected Equivalent(%q, FromText(%q)) to be %t", test.text, test.jira, test.equivalent)
+			}
+		})
+	}
+}
+
+func TestToTextFlattensUnsupportedNodes(t *testing.T) {
+	doc := &Node{Type: "doc", Version: 1, Content: []*Node{
+		{Type: "heading", Attrs: map[string]interface{}{"level": 1}, Content: []*Node{{Type: "text", Text: "Title", Marks: []*Mark{{Type: "strong"}}}}},
+		{Type: "panel", Content: []*Node{
+			{Type: "paragraph", Content: []*Node{{Type: "text", Text: "first"}}},
+			{Type: "paragraph", Content: []*Node{{Type: "text", Text: "second"}}},
+		}},
+	}}
+
+	if got, want := ToText(doc), "Title\n\nfirst\nsecond"; got != want {
+		t.Errorf("expected %q, got: %q", want, got)
+	}
+	if got := ToText(nil); got != "" {
+		t.Errorf("expected no text for no document, got: %q", got)
+	}
+}
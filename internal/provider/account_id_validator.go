@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = accountIDValidator{}
+
+// accountIDValidator rejects values that are obviously email addresses rather than Jira account IDs.
+// Jira answers those with a confusing 404, so catching them at plan time saves a round trip.
+type accountIDValidator struct{}
+
+// isAccountID returns a validator that rejects email addresses in account ID attributes.
+func isAccountID() validator.String {
+	return accountIDValidator{}
+}
+
+func (v accountIDValidator) Description(ctx context.Context) string {
+	return "value must be a Jira account ID, not an email address"
+}
+
+func (v accountIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v accountIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !strings.Contains(value, "@") {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Email address instead of account ID",
+		fmt.Sprintf("The value %q looks like an email address, but Jira expects an account ID here. ", value)+
+			"Look the account ID up with the `jiracloud_user` data source, e.g. `data.jiracloud_user.someone.account_id` "+
+			"with `email` set to the address.",
+	)
+}
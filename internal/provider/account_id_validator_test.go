@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAccountIDValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{"account ID", types.StringValue("557058:f58131cb-b67d-43c7-b30d-6b58d40bd077"), false},
+		{"legacy account ID", types.StringValue("5b10ac8d82e05b22cc7d4ef5"), false},
+		{"email address", types.StringValue("jane.doe@example.com"), true},
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("lead"), ConfigValue: test.value}
+			resp := &validator.StringResponse{}
+
+			isAccountID().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != test.wantErr {
+				t.Errorf("expected an error: %v, got: %v", test.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"net/http"
+)
+
+// bearerAuthTransport authenticates the requests with a bearer token,
+// e.g. a scoped API token or the token expected by a gateway in front of Jira.
+// go-jira only ships basic and JWT authentication transports.
+type bearerAuthTransport struct {
+	Token string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It defaults to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+func (t *bearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	authenticatedReq := req.Clone(req.Context())
+	authenticatedReq.Header.Set("Authorization", "Bearer "+t.Token)
+
+	return t.transport().RoundTrip(authenticatedReq)
+}
+
+// Client returns an *http.Client that makes requests authenticated with the bearer token.
+func (t *bearerAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *bearerAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+
+	return http.DefaultTransport
+}
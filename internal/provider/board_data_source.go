@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// agilePageSize is the number of boards or sprints requested per page of the Agile API.
+const agilePageSize = 50
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraBoardDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraBoardDataSource{}
+)
+
+func NewJiraBoardDataSource() datasource.DataSource {
+	return &JiraBoardDataSource{}
+}
+
+// JiraBoardDataSource defines the data source implementation.
+type JiraBoardDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// boardDetails is a single board of the Agile API.
+type boardDetails struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Location struct {
+		ProjectKey string `json:"projectKey"`
+	} `json:"location"`
+}
+
+// boardsPage is a single page of the boards endpoint of the Agile API.
+type boardsPage struct {
+	IsLast bool           `json:"isLast"`
+	Values []boardDetails `json:"values"`
+}
+
+func (d *JiraBoardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraBoardDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Project    types.String `tfsdk:"project"`
+	ID         types.Int64  `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	ProjectKey types.String `tfsdk:"project_key"`
+}
+
+func (d *JiraBoardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board"
+}
+
+func (d *JiraBoardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a Jira Software board by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The exact name of the board. It is an error if several boards have that name, " +
+					"in which case `project` helps telling them apart.",
+				Required: true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "Only look up the boards of the Jira project with this key.",
+				Optional:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the board.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the board, e.g. `scrum` or `kanban`.",
+				Computed:            true,
+			},
+			"project_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the project the board is located in, if any.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JiraBoardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraBoardDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name filter of the endpoint matches parts of names as well, so the exact match is picked afterwards.
+	query := url.Values{}
+	query.Set("name", state.Name.ValueString())
+	query.Set("maxResults", strconv.Itoa(agilePageSize))
+	if !state.Project.IsNull() {
+		query.Set("projectKeyOrId", state.Project.ValueString())
+	}
+
+	var matches []boardDetails
+	for startAt := 0; ; startAt += agilePageSize {
+		query.Set("startAt", strconv.Itoa(startAt))
+
+		page := new(boardsPage)
+		_, err := doJiraRequest(ctx, d.client, http.MethodGet, "rest/agile/1.0/board?"+query.Encode(), nil, page)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to search boards",
+				fmt.Sprintf("An unexpected error occurred while searching the boards named %s... ", state.Name.ValueString())+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+
+		for _, board := range page.Values {
+			if board.Name == state.Name.ValueString() {
+				matches = append(matches, board)
+			}
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Failed to find board",
+			fmt.Sprintf("Found %d boards named %s, expected exactly one. ", len(matches), state.Name.ValueString())+
+				"Set `project` to narrow the search down to the boards of a project.",
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(matches[0].ID)
+	state.Type = types.StringValue(matches[0].Type)
+	state.ProjectKey = stringValueOrNull(matches[0].Location.ProjectKey)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BoardEstimationResource{}
+	_ resource.ResourceWithConfigure   = &BoardEstimationResource{}
+	_ resource.ResourceWithImportState = &BoardEstimationResource{}
+)
+
+func NewBoardEstimationResource() resource.Resource {
+	return &BoardEstimationResource{}
+}
+
+// BoardEstimationResource defines the resource implementation.
+type BoardEstimationResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// boardEstimationField mirrors the payload returned by the Agile board estimation endpoint.
+type boardEstimationField struct {
+	FieldID     string `json:"fieldId"`
+	DisplayName string `json:"displayName"`
+}
+
+// boardEstimationUpdate is the payload accepted by the Agile board estimation endpoint.
+type boardEstimationUpdate struct {
+	Value string `json:"value"`
+}
+
+// boardConfigurationRanking holds the part of the board configuration that describes ranking.
+// The go-jira BoardConfiguration type does not expose it.
+type boardConfigurationRanking struct {
+	Ranking struct {
+		RankCustomFieldID int64 `json:"rankCustomFieldId"`
+	} `json:"ranking"`
+}
+
+func (r *BoardEstimationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraBoardEstimationResourceModel struct {
+	BoardID           types.Int64  `tfsdk:"board_id"`
+	FieldID           types.String `tfsdk:"field_id"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	RankCustomFieldID types.Int64  `tfsdk:"rank_custom_field_id"`
+}
+
+func (r *BoardEstimationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board_estimation"
+}
+
+func (r *BoardEstimationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the estimation statistic of a Jira Software board. " +
+			"Destroying this resource leaves the board's current estimation setting in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"board_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the board to configure.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the field used for estimation, e.g. `customfield_10016` for story points " +
+					"or `timeoriginalestimate` for the original time estimate.",
+				Required: true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the estimation field.",
+				Computed:            true,
+			},
+			"rank_custom_field_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the custom field used for ranking issues on the board. " +
+					"Jira does not allow changing the ranking field through its API, so it is reported for reference only.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *BoardEstimationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraBoardEstimationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setEstimation(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure board estimation",
+			fmt.Sprintf("An unexpected error occurred while configuring the estimation of board %d... ", state.BoardID.ValueInt64())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Configured board estimation", map[string]interface{}{"board_id": state.BoardID.ValueInt64()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BoardEstimationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraBoardEstimationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setEstimation(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update board estimation",
+			fmt.Sprintf("An unexpected error occurred while updating the estimation of board %d... ", state.BoardID.ValueInt64())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated board estimation", map[string]interface{}{"board_id": state.BoardID.ValueInt64()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BoardEstimationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraBoardEstimationResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	boardID := state.BoardID.ValueInt64()
+
+	estimation := new(boardEstimationField)
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/agile/1.0/board/%d/estimation", boardID), nil, estimation)
+	if err != nil && isNotFound(response) {
+		tflog.Trace(ctx, "Board no longer exists", map[string]interface{}{"board_id": boardID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err == nil {
+		err = r.readRanking(ctx, &state)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read board estimation",
+			fmt.Sprintf("An unexpected error occurred while reading the estimation of board %d... ", boardID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.FieldID = types.StringValue(estimation.FieldID)
+	state.DisplayName = types.StringValue(estimation.DisplayName)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BoardEstimationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A board always has an estimation statistic, so there is nothing to remove on the Jira side.
+	var state JiraBoardEstimationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	tflog.Trace(ctx, "Removed board estimation from state without changing the board", map[string]interface{}{"board_id": state.BoardID.ValueInt64()})
+}
+
+func (r *BoardEstimationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	boardID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Resource ImportState Invalid ID",
+			"Resource import ID must be the numeric ID of the board.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("board_id"), boardID)...)
+}
+
+// setEstimation sends the configured estimation field to Jira and refreshes the computed attributes of the model.
+func (r *BoardEstimationResource) setEstimation(ctx context.Context, state *JiraBoardEstimationResourceModel) error {
+	estimation := new(boardEstimationField)
+	payload := boardEstimationUpdate{Value: state.FieldID.ValueString()}
+	_, err := doJiraRequest(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/agile/1.0/board/%d/estimation", state.BoardID.ValueInt64()), payload, estimation)
+	if err != nil {
+		return err
+	}
+
+	state.FieldID = types.StringValue(estimation.FieldID)
+	state.DisplayName = types.StringValue(estimation.DisplayName)
+
+	return r.readRanking(ctx, state)
+}
+
+// readRanking fetches the board configuration and stores the ranking field of the board in the model.
+func (r *BoardEstimationResource) readRanking(ctx context.Context, state *JiraBoardEstimationResourceModel) error {
+	configuration := new(boardConfigurationRanking)
+	_, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/agile/1.0/board/%d/configuration", state.BoardID.ValueInt64()), nil, configuration)
+	if err != nil {
+		return err
+	}
+
+	state.RankCustomFieldID = types.Int64Value(configuration.Ranking.RankCustomFieldID)
+
+	return nil
+}
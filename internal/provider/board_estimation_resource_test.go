@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// handleBoardEstimation serves the estimation and the configuration endpoints of board 42 from the fake Jira.
+// The estimation field starts as story points.
+func handleBoardEstimation(fake *fakeJira) {
+	fields := map[string]string{
+		"customfield_10016":    "Story Points",
+		"timeoriginalestimate": "Original Time Estimate",
+	}
+	estimation := boardEstimationField{FieldID: "customfield_10016", DisplayName: fields["customfield_10016"]}
+
+	fake.handle(http.MethodGet, "/rest/agile/1.0/board/42/estimation", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, estimation)
+	})
+	fake.handle(http.MethodPut, "/rest/agile/1.0/board/42/estimation", func(w http.ResponseWriter, r *http.Request) {
+		var update boardEstimationUpdate
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			writeJiraError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		displayName, found := fields[update.Value]
+		if !found {
+			writeJiraError(w, http.StatusBadRequest, "The field "+update.Value+" can't be used for estimation.")
+			return
+		}
+
+		estimation = boardEstimationField{FieldID: update.Value, DisplayName: displayName}
+		writeJSON(w, http.StatusOK, estimation)
+	})
+	fake.respond(http.MethodGet, "/rest/agile/1.0/board/42/configuration", http.StatusOK, map[string]interface{}{
+		"ranking": map[string]int64{"rankCustomFieldId": 10019},
+	})
+}
+
+func TestBoardEstimationResource_ChangeField(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewBoardEstimationResource(), providerData)
+	handleBoardEstimation(fake)
+
+	state := h.importState("42")
+
+	var imported JiraBoardEstimationResourceModel
+	h.get(state, &imported)
+	if imported.FieldID.ValueString() != "customfield_10016" || imported.DisplayName.ValueString() != "Story Points" || imported.RankCustomFieldID.ValueInt64() != 10019 {
+		t.Fatalf("unexpected imported board estimation: %+v", imported)
+	}
+
+	// Changing the field is done in place.
+	plan := imported
+	plan.FieldID = types.StringValue("timeoriginalestimate")
+	plan.DisplayName = types.StringUnknown()
+	if _, requiresReplace := h.modifyPlan(state, plan); len(requiresReplace) > 0 {
+		t.Errorf("expected changing the estimation field to be done in place, got replacement for: %v", requiresReplace)
+	}
+	state = h.update(state, plan)
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the board estimation to be found")
+	}
+
+	var updated JiraBoardEstimationResourceModel
+	h.get(state, &updated)
+	if updated.FieldID.ValueString() != "timeoriginalestimate" || updated.DisplayName.ValueString() != "Original Time Estimate" {
+		t.Errorf("expected the board to be estimated with the original time estimate, got: %+v", updated)
+	}
+
+	// A field Jira can't estimate with fails the update.
+	plan = updated
+	plan.FieldID = types.StringValue("summary")
+	plan.DisplayName = types.StringUnknown()
+	if _, diags := h.tryUpdate(state, plan); !diags.HasError() {
+		t.Error("expected estimating with the summary to fail")
+	}
+}
+
+func TestBoardEstimationResource_ReadDeletedBoard(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewBoardEstimationResource(), providerData)
+	handleBoardEstimation(fake)
+
+	state := h.importState("42")
+
+	fake.respond(http.MethodGet, "/rest/agile/1.0/board/42/estimation", http.StatusNotFound, fakeJiraError{ErrorMessages: []string{"The requested board cannot be viewed because it either does not exist or you do not have permission to view it."}})
+
+	if _, found := h.read(state); found {
+		t.Error("expected the estimation of a deleted board to be removed from the state")
+	}
+}
@@ -3,17 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	jira "github.com/andygrunwald/go-jira/v2/cloud"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ datasource.DataSource              = &JiraComponentDataSource{}
-	_ datasource.DataSourceWithConfigure = &JiraComponentDataSource{}
+	_ datasource.DataSource                   = &JiraComponentDataSource{}
+	_ datasource.DataSourceWithConfigure      = &JiraComponentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &JiraComponentDataSource{}
 )
 
 func NewJiraComponentDataSource() datasource.DataSource {
@@ -22,7 +27,9 @@ func NewJiraComponentDataSource() datasource.DataSource {
 
 // JiraComponentDataSource defines the data source implementation.
 type JiraComponentDataSource struct {
-	client *jira.Client
+	client         *jira.Client
+	requestTimeout time.Duration
+	projects       *projectCache
 }
 
 func (d *JiraComponentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -31,24 +38,30 @@ func (d *JiraComponentDataSource) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+	d.projects = providerData.Projects
 }
 
 type JiraComponentDataSourceModel struct {
-	Project     types.String `tfsdk:"project"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Lead        types.String `tfsdk:"lead"`
+	ID                    types.String `tfsdk:"id"`
+	Project               types.String `tfsdk:"project"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	AssigneeType          types.String `tfsdk:"assignee_type"`
+	RealAssigneeType      types.String `tfsdk:"real_assignee_type"`
+	RealAssigneeAccountID types.String `tfsdk:"real_assignee_account_id"`
+	Lead                  types.String `tfsdk:"lead"`
 }
 
 func (d *JiraComponentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -61,19 +74,40 @@ func (d *JiraComponentDataSource) Schema(ctx context.Context, req datasource.Sch
 		MarkdownDescription: "Jira Component Data Source",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira component. Exactly one of `id` and `name` must be set. " +
+					"Set it to pick one of several components with the same name.",
+				Optional: true,
+				Computed: true,
+			},
 			"project": schema.StringAttribute{
 				MarkdownDescription: "The Jira project key that the component belongs to.",
 				Required:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Jira component.",
-				Required:            true,
+				MarkdownDescription: "The name of the Jira component. Exactly one of `id` and `name` must be set. " +
+					"Jira allows several components of a project to have the same name, in which case the lookup fails and `id` must be used.",
+				Optional: true,
+				Computed: true,
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the Jira component.",
 				Optional:            true,
 				Computed:            true,
 			},
+			"assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The assignee type configured on the Jira component.",
+				Computed:            true,
+			},
+			"real_assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The assignee type Jira effectively applies to issues created with the component. " +
+					"It differs from `assignee_type` when the configured assignee can't be used, e.g. `COMPONENT_LEAD` without a lead.",
+				Computed: true,
+			},
+			"real_assignee_account_id": schema.StringAttribute{
+				MarkdownDescription: "The Jira account ID of the user Jira effectively assigns issues created with the component to, if any.",
+				Computed:            true,
+			},
 			"lead": schema.StringAttribute{
 				MarkdownDescription: "The lead of the Jira component represented by their Jira account ID.",
 				Optional:            true,
@@ -83,56 +117,82 @@ func (d *JiraComponentDataSource) Schema(ctx context.Context, req datasource.Sch
 	}
 }
 
-func (d *JiraComponentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state JiraComponentDataSourceModel
+func (d *JiraComponentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config JiraComponentDataSourceModel
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	project, _, err := d.client.Project.Get(context.Background(), state.Project.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Failed to read %s project", state.Project.ValueString()),
-			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.Project.ValueString())+
-				"Jira Cloud client error: "+err.Error(),
+	if !config.ID.IsUnknown() && !config.Name.IsUnknown() && config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid component reference",
+			"Exactly one of `id` and `name` must be set.",
 		)
+	}
+}
+
+func (d *JiraComponentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraComponentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var projectComponentSimple jira.ProjectComponent
-	for _, component := range project.Components {
-		if component.Name == state.Name.ValueString() {
-			projectComponentSimple = component
-			break
+	componentID := state.ID.ValueString()
+	if state.ID.IsNull() {
+		componentID = d.findComponentID(ctx, state.Project.ValueString(), state.Name.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if projectComponentSimple.ID == "" {
+	projectComponentEnriched, response, err := d.client.Component.Get(ctx, componentID)
+	if err != nil {
+		if isNotFound(response) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Failed to find component",
+				"Could not find a component with the ID: "+componentID,
+			)
+			return
+		}
+
 		resp.Diagnostics.AddError(
-			"Failed to find component",
-			"Could not find a component with the name: "+state.Name.String(),
+			"Failed to read component",
+			fmt.Sprintf("An unexpected error occurred while reading the component %s... ", componentID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
 		)
 		return
 	}
 
-	projectComponentEnriched, _, err := d.client.Component.Get(context.Background(), projectComponentSimple.ID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read component",
-			fmt.Sprintf("An unexpected error occurred while reading the \"%s\" component", state.Name.ValueString())+
-				"Jira Cloud client error: "+err.Error(),
+	if !strings.EqualFold(projectComponentEnriched.Project, state.Project.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Component belongs to another project",
+			fmt.Sprintf("The component %s belongs to the %s project, not to the %s project.",
+				componentID, projectComponentEnriched.Project, state.Project.ValueString()),
 		)
 		return
 	}
 
 	state = JiraComponentDataSourceModel{
-		Project:     types.StringValue(project.Key),
-		Name:        types.StringValue(projectComponentEnriched.Name),
-		Description: types.StringValue(projectComponentEnriched.Description),
-		Lead:        types.StringValue(projectComponentEnriched.Lead.AccountID),
+		ID:                    types.StringValue(projectComponentEnriched.ID),
+		Project:               types.StringValue(projectComponentEnriched.Project),
+		Name:                  types.StringValue(projectComponentEnriched.Name),
+		Description:           types.StringValue(projectComponentEnriched.Description),
+		AssigneeType:          types.StringValue(projectComponentEnriched.AssigneeType),
+		RealAssigneeType:      types.StringValue(projectComponentEnriched.RealAssigneeType),
+		RealAssigneeAccountID: stringValueOrNull(projectComponentEnriched.RealAssignee.AccountID),
+		Lead:                  types.StringValue(projectComponentEnriched.Lead.AccountID),
 	}
 
 	// Save data into Terraform state
@@ -142,3 +202,44 @@ func (d *JiraComponentDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 }
+
+// findComponentID returns the ID of the only component of the project with the given name.
+// As Jira doesn't require component names to be unique, it fails rather than picking one of several components.
+func (d *JiraComponentDataSource) findComponentID(ctx context.Context, projectKey, name string, diags *diag.Diagnostics) string {
+	project, err := d.projects.get(ctx, d.client, projectKey)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to read %s project", projectKey),
+			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", projectKey)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return ""
+	}
+
+	var componentIDs []string
+	for _, component := range project.Components {
+		if component.Name == name {
+			componentIDs = append(componentIDs, component.ID)
+		}
+	}
+
+	switch len(componentIDs) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Failed to find component",
+			fmt.Sprintf("Could not find a component with the name %q in the %s project.", name, projectKey),
+		)
+		return ""
+	case 1:
+		return componentIDs[0]
+	default:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Ambiguous component name",
+			fmt.Sprintf("The %s project has %d components named %q, with the IDs %s. Set `id` instead of `name` to choose one of them.",
+				projectKey, len(componentIDs), name, strings.Join(componentIDs, ", ")),
+		)
+		return ""
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestComponentDataSource_DuplicateNames(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newDataSourceHarness(t, NewJiraComponentDataSource(), providerData)
+	first := fake.addComponent(projectKey, "Backend")
+	second := fake.addComponent(projectKey, "Backend")
+	fake.addComponent(projectKey, "Frontend")
+
+	lookup := func(id, name types.String) JiraComponentDataSourceModel {
+		return JiraComponentDataSourceModel{
+			ID:                    id,
+			Project:               types.StringValue(projectKey),
+			Name:                  name,
+			Description:           types.StringNull(),
+			AssigneeType:          types.StringNull(),
+			RealAssigneeType:      types.StringNull(),
+			RealAssigneeAccountID: types.StringNull(),
+			Lead:                  types.StringNull(),
+		}
+	}
+
+	// By name, the lookup must fail rather than pick one of the components.
+	var component JiraComponentDataSourceModel
+	diags := h.tryRead(lookup(types.StringNull(), types.StringValue("Backend")), &component)
+	if !diags.HasError() {
+		t.Fatalf("expected the lookup of a duplicate name to fail, got: %+v", component)
+	}
+	if diags[0].Summary() != "Ambiguous component name" ||
+		!strings.Contains(diags[0].Detail(), first.ID) || !strings.Contains(diags[0].Detail(), second.ID) {
+		t.Errorf("expected the diagnostic to list the IDs %s and %s, got: %s: %s", first.ID, second.ID, diags[0].Summary(), diags[0].Detail())
+	}
+
+	// By ID, each of them can be read.
+	for _, id := range []string{first.ID, second.ID} {
+		var component JiraComponentDataSourceModel
+		h.read(lookup(types.StringValue(id), types.StringNull()), &component)
+		if component.ID.ValueString() != id || component.Name.ValueString() != "Backend" || component.Project.ValueString() != projectKey {
+			t.Errorf("expected the component %s, got: %+v", id, component)
+		}
+	}
+
+	// A unique name is still enough.
+	h.read(lookup(types.StringNull(), types.StringValue("Frontend")), &component)
+	if component.Name.ValueString() != "Frontend" || component.ID.IsNull() {
+		t.Errorf("expected the Frontend component, got: %+v", component)
+	}
+
+	// Exactly one of id and name must be set.
+	diags = h.validate(lookup(types.StringValue(first.ID), types.StringValue("Backend")))
+	if !diags.HasError() {
+		t.Fatal("expected setting both id and name to be invalid")
+	}
+	if withPath, ok := diags[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root("name")) {
+		t.Errorf("expected the diagnostic to be about the name, got: %v", diags)
+	}
+}
@@ -4,23 +4,31 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	jira "github.com/andygrunwald/go-jira/v2/cloud"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ComponentResource{}
-	_ resource.ResourceWithConfigure   = &ComponentResource{}
-	_ resource.ResourceWithImportState = &ComponentResource{}
+	_ resource.Resource                   = &ComponentResource{}
+	_ resource.ResourceWithConfigure      = &ComponentResource{}
+	_ resource.ResourceWithImportState    = &ComponentResource{}
+	_ resource.ResourceWithValidateConfig = &ComponentResource{}
 )
 
 func NewComponentResource() resource.Resource {
@@ -29,7 +37,9 @@ func NewComponentResource() resource.Resource {
 
 // ComponentResource defines the resource implementation.
 type ComponentResource struct {
-	client *jira.Client
+	client         *jira.Client
+	requestTimeout time.Duration
+	projects       *projectCache
 }
 
 func (r *ComponentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -38,26 +48,42 @@ func (r *ComponentResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+	r.projects = providerData.Projects
+}
+
+// componentUpdateOptions mirrors jira.ComponentCreateOptions, except that the description and the lead account ID
+// are always serialized so that clearing them in Terraform clears them in Jira as well.
+type componentUpdateOptions struct {
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description"`
+	LeadAccountId string `json:"leadAccountId"`
+	AssigneeType  string `json:"assigneeType,omitempty"`
+	Project       string `json:"project,omitempty"`
 }
 
 type JiraComponentResourceModel struct {
-	Project      types.String `tfsdk:"project"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	AssigneeType types.String `tfsdk:"assignee_type"`
-	Lead         types.String `tfsdk:"lead"`
+	ID                    types.String `tfsdk:"id"`
+	Project               types.String `tfsdk:"project"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	AssigneeType          types.String `tfsdk:"assignee_type"`
+	RealAssigneeType      types.String `tfsdk:"real_assignee_type"`
+	RealAssigneeAccountID types.String `tfsdk:"real_assignee_account_id"`
+	Lead                  types.String `tfsdk:"lead"`
+	MoveIssuesTo          types.String `tfsdk:"move_issues_to"`
 }
 
 func (r *ComponentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -70,12 +96,23 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 		MarkdownDescription: "Jira Component Data Source",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira component.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The Jira project key that the component belongs to.",
-				Required:            true,
+				MarkdownDescription: "The Jira project key that the component belongs to. " +
+					"Jira can't move components between projects, so changing it replaces the component.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Jira component.",
+				MarkdownDescription: "The name of the Jira component. Changing it renames the component in place.",
 				Required:            true,
 			},
 			"description": schema.StringAttribute{
@@ -84,22 +121,65 @@ func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:            true,
 			},
 			"assignee_type": schema.StringAttribute{
-				MarkdownDescription: "The assignee type of the Jira component." +
+				MarkdownDescription: "The assignee type of the Jira component. " +
 					"Valid values are `PROJECT_DEFAULT`, `COMPONENT_LEAD`, `PROJECT_LEAD`, `UNASSIGNED`.",
 				Optional: true,
 				Default:  stringdefault.StaticString("PROJECT_DEFAULT"),
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED"),
+				},
 			},
-			"lead": schema.StringAttribute{
-				MarkdownDescription: "The lead of the Jira component represented by their Jira account ID.",
-				Optional:            true,
+			"real_assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The assignee type Jira effectively applies to issues created with the component. " +
+					"It differs from `assignee_type` when the configured assignee can't be used, e.g. `COMPONENT_LEAD` without a lead.",
+				Computed: true,
+			},
+			"real_assignee_account_id": schema.StringAttribute{
+				MarkdownDescription: "The Jira account ID of the user Jira effectively assigns issues created with the component to, if any.",
 				Computed:            true,
 			},
+			"lead": schema.StringAttribute{
+				MarkdownDescription: "The lead of the Jira component represented by their Jira account ID. " +
+					"Removing the attribute clears the lead of the component.",
+				Optional: true,
+				Validators: []validator.String{
+					isAccountID(),
+				},
+			},
+			"move_issues_to": schema.StringAttribute{
+				MarkdownDescription: "The ID of the component to move the issues of this component to when it is deleted. " +
+					"If not set, the issues are left without the component.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+func (r *ComponentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraComponentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Jira accepts this combination, but silently falls back to another assignee, see real_assignee_type.
+	if config.AssigneeType.ValueString() == "COMPONENT_LEAD" && config.Lead.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("assignee_type"),
+			"Component lead assignee without a lead",
+			"The `assignee_type` is `COMPONENT_LEAD`, but the component has no `lead`. "+
+				"Jira assigns the issues created with the component to the project default assignee instead.",
+		)
+	}
+}
+
 func (r *ComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
 	var state JiraComponentResourceModel
 
 	// Read Terraform plan data into the model
@@ -117,67 +197,71 @@ func (r *ComponentResource) Create(ctx context.Context, req resource.CreateReque
 		AssigneeType:  state.AssigneeType.ValueString(),
 	}
 
-	newComponent, _, err := r.client.Component.Create(context.Background(), &options)
+	newComponent, _, err := r.client.Component.Create(ctx, &options)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create component",
 			fmt.Sprintf("An unexpected error occurred while creating a new component named %s... ", state.Name.ValueString())+
-				"Jira Cloud client error: "+err.Error(),
+				"Jira Cloud client error: "+jiraErrorDetail(err),
 		)
 		return
 	}
 
+	r.projects.invalidate(state.Project.ValueString())
+
 	state = JiraComponentResourceModel{
-		Project:      types.StringValue(newComponent.Project),
-		Name:         types.StringValue(newComponent.Name),
-		Description:  types.StringValue(newComponent.Description),
-		AssigneeType: types.StringValue(newComponent.AssigneeType),
-		Lead:         types.StringValue(newComponent.Lead.AccountID),
+		ID:                    types.StringValue(newComponent.ID),
+		Project:               types.StringValue(newComponent.Project),
+		Name:                  types.StringValue(newComponent.Name),
+		Description:           types.StringValue(newComponent.Description),
+		AssigneeType:          types.StringValue(newComponent.AssigneeType),
+		RealAssigneeType:      types.StringValue(newComponent.RealAssigneeType),
+		RealAssigneeAccountID: stringValueOrNull(newComponent.RealAssignee.AccountID),
+		Lead:                  stringValueOrNull(newComponent.Lead.AccountID),
+		MoveIssuesTo:          state.MoveIssuesTo,
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("created a brand new component (ID: %s)", newComponent.ID))
+	tflog.Trace(ctx, "Created component", map[string]interface{}{"component_id": newComponent.ID, "project": state.Project.ValueString()})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state JiraComponentResourceModel
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
 
-	// Read Terraform plan data into the model
+	var state, priorState JiraComponentResourceModel
+
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	project, _, err := r.client.Project.Get(context.Background(), state.Project.ValueString())
+	// The component is looked up as it is known so far, since a rename changes the name in the plan.
+	componentID, err := r.componentID(ctx, &priorState)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to read %s project", state.Project.ValueString()),
 			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.Project.ValueString())+
-				"Jira Cloud client error: "+err.Error(),
+				"Jira Cloud client error: "+jiraErrorDetail(err),
 		)
 		return
 	}
 
-	var projectComponentSimple jira.ProjectComponent
-	for _, component := range project.Components {
-		if component.Name == state.Name.ValueString() {
-			projectComponentSimple = component
-			break
-		}
-	}
-
-	if projectComponentSimple.ID == "" {
+	if componentID == "" {
 		resp.Diagnostics.AddError(
 			"Failed to find component",
-			"Could not find a component with the name: "+state.Name.String(),
+			"Could not find a component with the name: "+priorState.Name.String(),
 		)
 		return
 	}
 
-	options := jira.ComponentCreateOptions{
+	// An empty lead account ID is sent on purpose, as that's how Jira removes the lead of a component.
+	options := componentUpdateOptions{
 		Name:          state.Name.ValueString(),
 		Description:   state.Description.ValueString(),
 		LeadAccountId: state.Lead.ValueString(),
@@ -185,42 +269,41 @@ func (r *ComponentResource) Update(ctx context.Context, req resource.UpdateReque
 		AssigneeType:  state.AssigneeType.ValueString(),
 	}
 
-	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s", projectComponentSimple.ID)
-	lowLevelRequestToJiraAPI, err := r.client.NewRequest(context.Background(), http.MethodPut, apiEndpoint, options)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to update component",
-			"An unexpected error occurred while preparing a low level request to Jira API to update an existing project component... "+
-				"Error: "+err.Error(),
-		)
-		return
-	}
-
 	updatedComponent := new(jira.ProjectComponent)
-	_, err = r.client.Do(lowLevelRequestToJiraAPI, updatedComponent)
+	_, err = doJiraRequest(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/component/%s", componentID), options, updatedComponent)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update component",
 			"An unexpected error occurred while updating an existing project component... "+
-				"Error: "+err.Error(),
+				"Jira Cloud client error: "+jiraErrorDetail(err),
 		)
 		return
 	}
 
+	r.projects.invalidate(state.Project.ValueString())
+
+	// Jira doesn't move components between projects, so the project is carried forward as configured rather than taken from the response.
 	state = JiraComponentResourceModel{
-		Project:      types.StringValue(updatedComponent.Project),
-		Name:         types.StringValue(updatedComponent.Name),
-		Description:  types.StringValue(updatedComponent.Description),
-		AssigneeType: types.StringValue(updatedComponent.AssigneeType),
-		Lead:         types.StringValue(updatedComponent.Lead.AccountID),
+		ID:                    types.StringValue(updatedComponent.ID),
+		Project:               state.Project,
+		Name:                  types.StringValue(updatedComponent.Name),
+		Description:           types.StringValue(updatedComponent.Description),
+		AssigneeType:          types.StringValue(updatedComponent.AssigneeType),
+		RealAssigneeType:      types.StringValue(updatedComponent.RealAssigneeType),
+		RealAssigneeAccountID: stringValueOrNull(updatedComponent.RealAssignee.AccountID),
+		Lead:                  stringValueOrNull(updatedComponent.Lead.AccountID),
+		MoveIssuesTo:          state.MoveIssuesTo,
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("created a brand new component (ID: %s)", updatedComponent.ID))
+	tflog.Trace(ctx, "Updated component", map[string]interface{}{"component_id": updatedComponent.ID, "project": state.Project.ValueString()})
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
 	var state JiraComponentResourceModel
 
 	// Read Terraform configuration data into the model
@@ -230,48 +313,49 @@ func (r *ComponentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	project, _, err := r.client.Project.Get(context.Background(), state.Project.ValueString())
+	componentID, err := r.componentID(ctx, &state)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to read %s project", state.Project.ValueString()),
 			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.Project.ValueString())+
-				"Jira Cloud client error: "+err.Error(),
+				"Jira Cloud client error: "+jiraErrorDetail(err),
 		)
 		return
 	}
 
-	var projectComponentSimple jira.ProjectComponent
-	for _, component := range project.Components {
-		if component.Name == state.Name.ValueString() {
-			projectComponentSimple = component
-			break
-		}
-	}
-
-	if projectComponentSimple.ID == "" {
-		resp.Diagnostics.AddError(
-			"Failed to find component",
-			"Could not find a component with the name: "+state.Name.String(),
-		)
+	// The component was deleted outside of Terraform, so it is removed from the state to be planned for creation again.
+	if componentID == "" {
+		tflog.Trace(ctx, "Component no longer exists", map[string]interface{}{"component_name": state.Name.ValueString(), "project": state.Project.ValueString()})
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	projectComponentEnriched, _, err := r.client.Component.Get(context.Background(), projectComponentSimple.ID)
+	projectComponentEnriched, response, err := r.client.Component.Get(ctx, componentID)
 	if err != nil {
+		if isNotFound(response) {
+			tflog.Trace(ctx, "Component no longer exists", map[string]interface{}{"component_id": componentID, "project": state.Project.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Failed to read component",
 			fmt.Sprintf("An unexpected error occurred while reading the \"%s\" component", state.Name.ValueString())+
-				"Jira Cloud client error: "+err.Error(),
+				"Jira Cloud client error: "+jiraErrorDetail(err),
 		)
 		return
 	}
 
 	state = JiraComponentResourceModel{
-		Project:      types.StringValue(projectComponentEnriched.Project),
-		Name:         types.StringValue(projectComponentEnriched.Name),
-		Description:  types.StringValue(projectComponentEnriched.Description),
-		AssigneeType: types.StringValue(projectComponentEnriched.AssigneeType),
-		Lead:         types.StringValue(projectComponentEnriched.Lead.AccountID),
+		ID:                    types.StringValue(projectComponentEnriched.ID),
+		Project:               state.Project,
+		Name:                  types.StringValue(projectComponentEnriched.Name),
+		Description:           types.StringValue(projectComponentEnriched.Description),
+		AssigneeType:          types.StringValue(projectComponentEnriched.AssigneeType),
+		RealAssigneeType:      types.StringValue(projectComponentEnriched.RealAssigneeType),
+		RealAssigneeAccountID: stringValueOrNull(projectComponentEnriched.RealAssignee.AccountID),
+		Lead:                  stringValueOrNull(projectComponentEnriched.Lead.AccountID),
+		MoveIssuesTo:          state.MoveIssuesTo,
 	}
 
 	// Save data into Terraform state
@@ -283,45 +367,209 @@ func (r *ComponentResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *ComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddError("Delete Not Implemented", "This resource does not support deletion.")
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraComponentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	componentID, err := r.componentID(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to read %s project", state.Project.ValueString()),
+			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// The component is already gone, so there is nothing left to delete.
+	if componentID == "" {
+		tflog.Trace(ctx, "Component no longer exists", map[string]interface{}{"component_name": state.Name.ValueString(), "project": state.Project.ValueString()})
+		return
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s", componentID)
+	if !state.MoveIssuesTo.IsNull() {
+		apiEndpoint += "?moveIssuesTo=" + url.QueryEscape(state.MoveIssuesTo.ValueString())
+	}
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, apiEndpoint, nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete component",
+			"An unexpected error occurred while deleting an existing project component... "+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	r.projects.invalidate(state.Project.ValueString())
+
+	tflog.Trace(ctx, "Deleted component", map[string]interface{}{"component_id": componentID, "project": state.Project.ValueString()})
 }
 
 func (r *ComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
 	// By default, a Jira Cloud Project key has to be in the format of [A-Z][A-Z]+
-	// This means we can safely assume that the first part of the import ID is the project key and the second part is the component name.
-	// The two parts are separated by a colon.
+	// This means we can safely assume that the first part of the import ID is the project key and the second part refers to the component.
+	// The two parts are separated by a colon, and the component can be referred to either by its name or by its numeric ID.
 	// Having both parts allows us to have unique components across different projects since the pair of project key and component name is unique per Jira Cloud instance.
+	// Component IDs are unique per instance as well, so a bare numeric ID is accepted too.
+
+	if _, err := strconv.Atoi(req.ID); err == nil {
+		r.importByID(ctx, req.ID, resp)
+		return
+	}
 
-	importIDParts := strings.Split(req.ID, ":")
-	if len(importIDParts) != 2 {
+	importIDParts := strings.SplitN(req.ID, ":", 2)
+	if len(importIDParts) != 2 || importIDParts[0] == "" || importIDParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Resource ImportState Invalid ID",
-			"Resource import ID must be in the format of `project_key:component_name`.",
+			"Resource import ID must be in the format of `project_key:component_name`, `project_key:component_id` or `component_id`, "+
+				"e.g. `MYPROJ:Backend`, `MYPROJ:10001` or `10001`.",
 		)
 		return
 	}
 
-	namePath := path.Root("name")
-	if namePath.Equal(path.Empty()) {
+	projectKey := importIDParts[0]
+	componentRef := importIDParts[1]
+
+	project, err := r.projects.get(ctx, r.client, projectKey)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Resource Import Passthrough Missing Attribute Name",
-			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-				"Resource ImportState method call to ImportStatePassthroughID path must be set to a valid attribute path that can accept a string value.",
+			fmt.Sprintf("Failed to read %s project", projectKey),
+			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", projectKey)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
 		)
+		return
+	}
+
+	// A numeric reference is matched against the component IDs first, since component names can be numeric as well.
+	var componentID string
+	if _, err := strconv.Atoi(componentRef); err == nil {
+		for _, component := range project.Components {
+			if component.ID == componentRef {
+				componentID = component.ID
+				break
+			}
+		}
 	}
 
-	projectPath := path.Root("project")
-	if projectPath.Equal(path.Empty()) {
+	if componentID == "" {
+		for _, component := range project.Components {
+			if component.Name == componentRef {
+				componentID = component.ID
+				break
+			}
+		}
+	}
+
+	if componentID == "" {
 		resp.Diagnostics.AddError(
-			"Resource Import Passthrough Missing Attribute Project",
-			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-				"Resource ImportState method call to ImportStatePassthroughID path must be set to a valid attribute path that can accept a string value.",
+			"Failed to find component",
+			fmt.Sprintf("Could not find a component with the name or ID %q in the %s project. ", componentRef, projectKey)+
+				"Resource import ID must be in the format of `project_key:component_name` or `project_key:component_id`.",
 		)
+		return
 	}
 
-	projectKey := importIDParts[0]
-	componentName := importIDParts[1]
+	projectComponentEnriched, _, err := r.client.Component.Get(ctx, componentID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read component",
+			fmt.Sprintf("An unexpected error occurred while reading the component %s... ", componentID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state := JiraComponentResourceModel{
+		ID:                    types.StringValue(projectComponentEnriched.ID),
+		Project:               types.StringValue(project.Key),
+		Name:                  types.StringValue(projectComponentEnriched.Name),
+		Description:           types.StringValue(projectComponentEnriched.Description),
+		AssigneeType:          types.StringValue(projectComponentEnriched.AssigneeType),
+		RealAssigneeType:      types.StringValue(projectComponentEnriched.RealAssigneeType),
+		RealAssigneeAccountID: stringValueOrNull(projectComponentEnriched.RealAssignee.AccountID),
+		Lead:                  stringValueOrNull(projectComponentEnriched.Lead.AccountID),
+		MoveIssuesTo:          types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// importByID imports the component with the given ID, whatever its project.
+func (r *ComponentResource) importByID(ctx context.Context, componentID string, resp *resource.ImportStateResponse) {
+	projectComponentEnriched, response, err := r.client.Component.Get(ctx, componentID)
+	if err != nil {
+		if isNotFound(response) {
+			resp.Diagnostics.AddError(
+				"Failed to find component",
+				fmt.Sprintf("Could not find a component with the ID %s.", componentID),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read component",
+			fmt.Sprintf("An unexpected error occurred while reading the component %s... ", componentID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state := JiraComponentResourceModel{
+		ID:                    types.StringValue(projectComponentEnriched.ID),
+		Project:               types.StringValue(projectComponentEnriched.Project),
+		Name:                  types.StringValue(projectComponentEnriched.Name),
+		Description:           types.StringValue(projectComponentEnriched.Description),
+		AssigneeType:          types.StringValue(projectComponentEnriched.AssigneeType),
+		RealAssigneeType:      types.StringValue(projectComponentEnriched.RealAssigneeType),
+		RealAssigneeAccountID: stringValueOrNull(projectComponentEnriched.RealAssignee.AccountID),
+		Lead:                  stringValueOrNull(projectComponentEnriched.Lead.AccountID),
+		MoveIssuesTo:          types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// componentID returns the ID of the component stored in the model.
+// State written before the ID was tracked only knows the component by name, so in that case the component is looked up in its project.
+// An empty ID is returned if the project has no component with that name.
+func (r *ComponentResource) componentID(ctx context.Context, state *JiraComponentResourceModel) (string, error) {
+	if state.ID.ValueString() != "" {
+		return state.ID.ValueString(), nil
+	}
+
+	return findComponentID(ctx, r.client, r.projects, state.Project.ValueString(), state.Name.ValueString())
+}
+
+// findComponentID returns the ID of the component with the given name in the given project,
+// or an empty string if the project has no such component.
+func findComponentID(ctx context.Context, client *jira.Client, projects *projectCache, projectKey, name string) (string, error) {
+	project, err := projects.get(ctx, client, projectKey)
+	if err != nil {
+		return "", err
+	}
+
+	for _, component := range project.Components {
+		if component.Name == name {
+			return component.ID, nil
+		}
+	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, namePath, componentName)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, projectPath, projectKey)...)
+	return "", nil
 }
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestComponentResource_CRUD(t *testing.T) {
+	providerData, _, projectKey := testJira(t)
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+
+	name := fmt.Sprintf("tf-test-%d", time.Now().UnixNano())
+
+	// Create
+	state := h.create(JiraComponentResourceModel{
+		ID:                    types.StringUnknown(),
+		Project:               types.StringValue(projectKey),
+		Name:                  types.StringValue(name),
+		Description:           types.StringValue("Created by Terraform"),
+		AssigneeType:          types.StringValue("PROJECT_DEFAULT"),
+		RealAssigneeType:      types.StringUnknown(),
+		RealAssigneeAccountID: types.StringUnknown(),
+		Lead:                  types.StringNull(),
+		MoveIssuesTo:          types.StringNull(),
+	})
+
+	var created JiraComponentResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() == "" {
+		t.Fatal("expected the created component to have an ID")
+	}
+	if created.Project.ValueString() != projectKey || created.Name.ValueString() != name || created.Description.ValueString() != "Created by Terraform" {
+		t.Errorf("unexpected created component: %+v", created)
+	}
+	if created.RealAssigneeType.IsUnknown() || created.RealAssigneeAccountID.IsUnknown() {
+		t.Errorf("expected the real assignee to be known after create, got: %+v", created)
+	}
+
+	// Read
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created component to be found")
+	}
+
+	var read JiraComponentResourceModel
+	h.get(state, &read)
+	if read != created {
+		t.Errorf("expected read to return the created component\ncreated: %+v\nread:    %+v", created, read)
+	}
+
+	// Update
+	plan := read
+	plan.Name = types.StringValue(name + "-renamed")
+	plan.Description = types.StringValue("Updated by Terraform")
+	plan.AssigneeType = types.StringValue("UNASSIGNED")
+	plan.RealAssigneeType = types.StringUnknown()
+	plan.RealAssigneeAccountID = types.StringUnknown()
+	state = h.update(state, plan)
+
+	var updated JiraComponentResourceModel
+	h.get(state, &updated)
+	if updated.ID != created.ID {
+		t.Errorf("expected the update to keep the ID %s, got: %s", created.ID, updated.ID)
+	}
+	if updated.Name.ValueString() != name+"-renamed" || updated.Description.ValueString() != "Updated by Terraform" {
+		t.Errorf("unexpected updated component: %+v", updated)
+	}
+	if updated.AssigneeType.ValueString() != "UNASSIGNED" || updated.RealAssigneeType.ValueString() != "UNASSIGNED" {
+		t.Errorf("expected the updated component to be unassigned, got: %+v", updated)
+	}
+
+	state, found = h.read(state)
+	if !found {
+		t.Fatal("expected the renamed component to be found")
+	}
+
+	// Import, by project and name as well as by ID
+	for _, id := range []string{projectKey + ":" + name + "-renamed", updated.ID.ValueString()} {
+		var imported JiraComponentResourceModel
+		h.get(h.importState(id), &imported)
+		if imported.ID != updated.ID || imported.Name != updated.Name || imported.Project.ValueString() != projectKey {
+			t.Errorf("import %q: expected the updated component, got: %+v", id, imported)
+		}
+	}
+
+	// Delete
+	h.delete(state)
+
+	if _, found := h.read(state); found {
+		t.Error("expected the deleted component to be removed from the state")
+	}
+}
+
+func TestComponentResource_ReadDeletedOutsideTerraform(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+	component := fake.addComponent(projectKey, "Backend")
+
+	state := h.importState(component.ID)
+
+	fake.deleteComponent(component.ID)
+
+	if _, found := h.read(state); found {
+		t.Error("expected the component deleted outside of Terraform to be removed from the state")
+	}
+}
+
+func TestComponentResource_ChangeProject(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+	component := fake.addComponent(projectKey, "Backend")
+	fake.addProject("OTHER", "Other project")
+
+	state := h.importState(component.ID)
+
+	var imported JiraComponentResourceModel
+	h.get(state, &imported)
+
+	// Jira can't move components between projects, so they are replaced.
+	plan := imported
+	plan.Project = types.StringValue("OTHER")
+	if _, requiresReplace := h.modifyPlan(state, plan); !requiresReplace.Contains(path.Root("project")) {
+		t.Errorf("expected changing the project of the component to force a replacement, got replacement for: %v", requiresReplace)
+	}
+
+	// Renames are done in place.
+	plan = imported
+	plan.Name = types.StringValue("Backend services")
+	if _, requiresReplace := h.modifyPlan(state, plan); len(requiresReplace) > 0 {
+		t.Errorf("expected renaming the component to be done in place, got replacement for: %v", requiresReplace)
+	}
+}
+
+func TestComponentResource_ValidateAssigneeType(t *testing.T) {
+	providerData, _, projectKey := testJira(t)
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+
+	for assigneeType, wantErr := range map[string]bool{
+		"PROJECT_DEFAULT": false,
+		"PROJECT_LEAD":    false,
+		"UNASSIGNED":      false,
+		"project_lead":    true,
+		"NOBODY":          true,
+	} {
+		diags := h.validate(JiraComponentResourceModel{
+			ID:                    types.StringNull(),
+			Project:               types.StringValue(projectKey),
+			Name:                  types.StringValue("Backend"),
+			Description:           types.StringNull(),
+			AssigneeType:          types.StringValue(assigneeType),
+			RealAssigneeType:      types.StringNull(),
+			RealAssigneeAccountID: types.StringNull(),
+			Lead:                  types.StringNull(),
+			MoveIssuesTo:          types.StringNull(),
+		})
+		if diags.HasError() != wantErr {
+			t.Errorf("assignee_type %q: expected an error: %v, got: %v", assigneeType, wantErr, diags)
+		}
+	}
+}
+
+func TestComponentResource_SecondApplyIsEmpty(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+	lead := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+
+	// The configuration leaves the description and the assignee type to their defaults.
+	config := JiraComponentResourceModel{
+		ID:                    types.StringNull(),
+		Project:               types.StringValue(projectKey),
+		Name:                  types.StringValue("Backend"),
+		Description:           types.StringNull(),
+		AssigneeType:          types.StringNull(),
+		RealAssigneeType:      types.StringNull(),
+		RealAssigneeAccountID: types.StringNull(),
+		Lead:                  types.StringValue(lead.AccountID),
+		MoveIssuesTo:          types.StringNull(),
+	}
+
+	state := h.create(JiraComponentResourceModel{
+		ID:                    types.StringUnknown(),
+		Project:               config.Project,
+		Name:                  config.Name,
+		Description:           types.StringUnknown(),
+		AssigneeType:          types.StringValue("PROJECT_DEFAULT"),
+		RealAssigneeType:      types.StringUnknown(),
+		RealAssigneeAccountID: types.StringUnknown(),
+		Lead:                  config.Lead,
+		MoveIssuesTo:          config.MoveIssuesTo,
+	})
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created component to be found")
+	}
+
+	planned, requiresReplace := h.planUpdate(state, config)
+	if len(requiresReplace) > 0 {
+		t.Errorf("expected the second apply not to replace the component, got replacement for: %v", requiresReplace)
+	}
+	if !planned.Raw.Equal(state.Raw) {
+		t.Errorf("expected the second apply to plan no change\nstate: %s\nplan:  %s", state.Raw, planned.Raw)
+	}
+}
+
+func TestComponentResource_SetAndClearLead(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+	component := fake.addComponent(projectKey, "Backend")
+	lead := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+
+	state := h.importState(component.ID)
+
+	var imported JiraComponentResourceModel
+	h.get(state, &imported)
+	if !imported.Lead.IsNull() {
+		t.Fatalf("expected the component to have no lead, got: %s", imported.Lead)
+	}
+
+	for _, want := range []types.String{types.StringValue(lead.AccountID), types.StringNull()} {
+		plan := imported
+		plan.Lead = want
+		plan.RealAssigneeType = types.StringUnknown()
+		plan.RealAssigneeAccountID = types.StringUnknown()
+		state = h.update(state, plan)
+
+		read, found := h.read(state)
+		if !found {
+			t.Fatal("expected the component to be found")
+		}
+
+		h.get(read, &imported)
+		if !imported.Lead.Equal(want) {
+			t.Errorf("expected the lead of the component to be %s, got: %s", want, imported.Lead)
+		}
+		if fake.components[component.ID].Lead.AccountID != want.ValueString() {
+			t.Errorf("expected Jira to have the lead %s, got: %s", want, fake.components[component.ID].Lead.AccountID)
+		}
+	}
+}
+
+func TestComponentResource_SetAndClearDescription(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+	component := fake.addComponent(projectKey, "Backend")
+
+	state := h.importState(component.ID)
+
+	var imported JiraComponentResourceModel
+	h.get(state, &imported)
+
+	for _, want := range []string{"Services behind the API", ""} {
+		plan := imported
+		plan.Description = types.StringValue(want)
+		plan.RealAssigneeType = types.StringUnknown()
+		plan.RealAssigneeAccountID = types.StringUnknown()
+		state = h.update(state, plan)
+
+		read, found := h.read(state)
+		if !found {
+			t.Fatal("expected the component to be found")
+		}
+
+		h.get(read, &imported)
+		if imported.Description.ValueString() != want {
+			t.Errorf("expected the description of the component to be %q, got: %s", want, imported.Description)
+		}
+		if fake.components[component.ID].Description != want {
+			t.Errorf("expected Jira to have the description %q, got: %q", want, fake.components[component.ID].Description)
+		}
+	}
+}
+
+func TestComponentResource_RealAssigneeType(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewComponentResource(), providerData)
+	projectLead := fake.project(projectKey).Lead.AccountID
+	lead := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+
+	tests := []struct {
+		name                 string
+		assigneeType         string
+		lead                 types.String
+		wantRealAssigneeType string
+		wantRealAssignee     string
+	}{
+		{"project default", "PROJECT_DEFAULT", types.StringNull(), "PROJECT_LEAD", projectLead},
+		{"project lead", "PROJECT_LEAD", types.StringNull(), "PROJECT_LEAD", projectLead},
+		{"component lead", "COMPONENT_LEAD", types.StringValue(lead.AccountID), "COMPONENT_LEAD", lead.AccountID},
+		{"component lead without a lead", "COMPONENT_LEAD", types.StringNull(), "PROJECT_LEAD", projectLead},
+		{"unassigned", "UNASSIGNED", types.StringNull(), "UNASSIGNED", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := h.create(JiraComponentResourceModel{
+				ID:                    types.StringUnknown(),
+				Project:               types.StringValue(projectKey),
+				Name:                  types.StringValue(test.name),
+				Description:           types.StringNull(),
+				AssigneeType:          types.StringValue(test.assigneeType),
+				RealAssigneeType:      types.StringUnknown(),
+				RealAssigneeAccountID: types.StringUnknown(),
+				Lead:                  test.lead,
+				MoveIssuesTo:          types.StringNull(),
+			})
+
+			var created JiraComponentResourceModel
+			h.get(state, &created)
+			if created.AssigneeType.ValueString() != test.assigneeType {
+				t.Errorf("expected the configured assignee type %s to be kept, got: %s", test.assigneeType, created.AssigneeType)
+			}
+			if created.RealAssigneeType.ValueString() != test.wantRealAssigneeType || created.RealAssigneeAccountID.ValueString() != test.wantRealAssignee {
+				t.Errorf("expected issues to be assigned to %s (%s), got: %s (%s)",
+					test.wantRealAssignee, test.wantRealAssigneeType, created.RealAssigneeAccountID, created.RealAssigneeType)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraComponentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraComponentsDataSource{}
+)
+
+func NewJiraComponentsDataSource() datasource.DataSource {
+	return &JiraComponentsDataSource{}
+}
+
+// JiraComponentsDataSource defines the data source implementation.
+type JiraComponentsDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+	projects       *projectCache
+}
+
+func (d *JiraComponentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+	d.projects = providerData.Projects
+}
+
+type JiraComponentsDataSourceModel struct {
+	Project    types.String                `tfsdk:"project"`
+	ProjectID  types.String                `tfsdk:"project_id"`
+	Lead       types.String                `tfsdk:"lead"`
+	Components []JiraComponentSummaryModel `tfsdk:"components"`
+}
+
+type JiraComponentSummaryModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	LeadAccountID types.String `tfsdk:"lead_account_id"`
+	AssigneeType  types.String `tfsdk:"assignee_type"`
+}
+
+func (d *JiraComponentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_components"
+}
+
+func (d *JiraComponentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists all the components of a Jira project, optionally only the ones led by a given person.",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira project to list the components of.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira project.",
+				Computed:            true,
+			},
+			"lead": schema.StringAttribute{
+				MarkdownDescription: "Only list the components led by this Jira account ID. Components without a lead are left out.",
+				Optional:            true,
+				Validators: []validator.String{
+					isAccountID(),
+				},
+			},
+			"components": schema.ListNestedAttribute{
+				MarkdownDescription: "The components of the project, or the ones matching `lead` if set. Empty if no component matches.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the component.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the component.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the component.",
+							Computed:            true,
+						},
+						"lead_account_id": schema.StringAttribute{
+							MarkdownDescription: "The Jira account ID of the component lead, if any.",
+							Computed:            true,
+						},
+						"assignee_type": schema.StringAttribute{
+							MarkdownDescription: "The default assignee of issues created with the component.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JiraComponentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraComponentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := d.projects.resolve(ctx, d.client, state.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to read %s project", state.Project.ValueString()),
+			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// Unlike the components embedded in the project, the ones of this endpoint include the lead.
+	var components []jira.ProjectComponent
+	_, err = doJiraRequest(ctx, d.client, http.MethodGet, fmt.Sprintf("rest/api/3/project/%s/components", project.ID), nil, &components)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read components",
+			fmt.Sprintf("An unexpected error occurred while reading the components of the %s project... ", state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.ProjectID = types.StringValue(project.ID)
+	state.Components = make([]JiraComponentSummaryModel, 0, len(components))
+	for _, component := range components {
+		if !state.Lead.IsNull() && component.Lead.AccountID != state.Lead.ValueString() {
+			continue
+		}
+
+		state.Components = append(state.Components, JiraComponentSummaryModel{
+			ID:            types.StringValue(component.ID),
+			Name:          types.StringValue(component.Name),
+			Description:   types.StringValue(component.Description),
+			LeadAccountID: stringValueOrNull(component.Lead.AccountID),
+			AssigneeType:  types.StringValue(component.AssigneeType),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &DashboardResource{}
+	_ resource.ResourceWithConfigure      = &DashboardResource{}
+	_ resource.ResourceWithImportState    = &DashboardResource{}
+	_ resource.ResourceWithValidateConfig = &DashboardResource{}
+)
+
+func NewDashboardResource() resource.Resource {
+	return &DashboardResource{}
+}
+
+// DashboardResource defines the resource implementation.
+type DashboardResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// dashboardDetails is a dashboard as exchanged with the Jira API.
+// The edit permissions are passed through untouched, so that updating a dashboard keeps the ones granted in Jira.
+type dashboardDetails struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	SharePermissions []sharePermission `json:"sharePermissions"`
+	EditPermissions  json.RawMessage   `json:"editPermissions"`
+	View             string            `json:"view,omitempty"`
+}
+
+func (r *DashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraDashboardResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	SharePermissions types.Set    `tfsdk:"share_permissions"`
+	ViewURL          types.String `tfsdk:"view_url"`
+}
+
+func (r *DashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *DashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Dashboard Resource, e.g. for templating the dashboards of teams.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira dashboard.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Jira dashboard.",
+				Optional:            true,
+			},
+			"share_permissions": sharePermissionsAttribute("Who the Jira dashboard is shared with. The dashboard is private to its owner when not set. " +
+				"The edit permissions of the dashboard are left as they are in Jira."),
+			"view_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to view the Jira dashboard at.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraDashboardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSharePermissions(ctx, config.SharePermissions)...)
+}
+
+func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraDashboardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, diags := dashboardFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard.EditPermissions = json.RawMessage("[]")
+
+	newDashboard := new(dashboardDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPost, "rest/api/3/dashboard", dashboard, newDashboard)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create dashboard",
+			fmt.Sprintf("An unexpected error occurred while creating a new dashboard named %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDashboardState(ctx, &state, newDashboard)...)
+
+	tflog.Trace(ctx, "Created dashboard", map[string]interface{}{"dashboard_id": newDashboard.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraDashboardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, diags := dashboardFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/dashboard/%s", state.ID.ValueString())
+
+	// The update replaces the edit permissions as well, so the current ones are sent back.
+	currentDashboard := new(dashboardDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodGet, apiEndpoint, nil, currentDashboard)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read dashboard",
+			fmt.Sprintf("An unexpected error occurred while reading the dashboard %s... ", state.ID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	dashboard.EditPermissions = currentDashboard.EditPermissions
+
+	updatedDashboard := new(dashboardDetails)
+	_, err = doJiraRequest(ctx, r.client, http.MethodPut, apiEndpoint, dashboard, updatedDashboard)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update dashboard",
+			fmt.Sprintf("An unexpected error occurred while updating the dashboard %s... ", state.ID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDashboardState(ctx, &state, updatedDashboard)...)
+
+	tflog.Trace(ctx, "Updated dashboard", map[string]interface{}{"dashboard_id": updatedDashboard.ID})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraDashboardResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard := new(dashboardDetails)
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/dashboard/%s", state.ID.ValueString()), nil, dashboard)
+	if err != nil {
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read dashboard",
+			fmt.Sprintf("An unexpected error occurred while reading the dashboard %s... ", state.ID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDashboardState(ctx, &state, dashboard)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraDashboardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/dashboard/%s", state.ID.ValueString()), nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete dashboard",
+			fmt.Sprintf("An unexpected error occurred while deleting the dashboard %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted dashboard", map[string]interface{}{"dashboard_id": state.ID.ValueString()})
+}
+
+func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// dashboardFromModel builds the Jira representation of the dashboard out of the model, edit permissions aside.
+func dashboardFromModel(ctx context.Context, state *JiraDashboardResourceModel) (*dashboardDetails, diag.Diagnostics) {
+	sharePermissions, diags := sharePermissionsFromModel(ctx, state.SharePermissions)
+
+	return &dashboardDetails{
+		Name:             state.Name.ValueString(),
+		Description:      state.Description.ValueString(),
+		SharePermissions: sharePermissions,
+	}, diags
+}
+
+// setDashboardState copies the attributes returned by Jira into the model.
+func setDashboardState(ctx context.Context, state *JiraDashboardResourceModel, dashboard *dashboardDetails) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(dashboard.ID)
+	state.Name = types.StringValue(dashboard.Name)
+	state.Description = stringValueOrNull(dashboard.Description)
+	state.ViewURL = types.StringValue(dashboard.View)
+	state.SharePermissions, diags = sharePermissionsValue(ctx, dashboard.SharePermissions, state.SharePermissions)
+
+	return diags
+}
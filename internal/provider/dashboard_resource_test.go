@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetDashboardState_SharePermissionsMatchConfig(t *testing.T) {
+	ctx := context.Background()
+
+	configured, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: sharePermissionAttrTypes}, []JiraSharePermissionModel{
+		{Type: types.StringValue("authenticated"), TargetID: types.StringNull()},
+		{Type: types.StringValue("project"), TargetID: types.StringValue("10000")},
+		{Type: types.StringValue("group"), TargetID: types.StringValue("276f955c-63d7-42c8-9520-92d01dca0625")},
+	})
+	if diags.HasError() {
+		t.Fatalf("building the configured share permissions: %v", diags)
+	}
+
+	// Jira answers with its own IDs, in its own order, with more details on the targets and the former name of the authenticated type.
+	dashboard := new(dashboardDetails)
+	err := json.Unmarshal([]byte(`{
+		"id": "10042",
+		"name": "Backend team",
+		"view": "https://example.atlassian.net/jira/dashboards/10042",
+		"sharePermissions": [
+			{"id": 10201, "type": "group", "group": {"name": "backend", "groupId": "276f955c-63d7-42c8-9520-92d01dca0625"}},
+			{"id": 10202, "type": "project", "project": {"id": "10000", "key": "ABC", "name": "Backend"}},
+			{"id": 10203, "type": "loggedin"}
+		],
+		"editPermissions": [{"id": 10204, "type": "user", "user": {"accountId": "5b10ac8d82e05b22cc7d4ef5"}}]
+	}`), dashboard)
+	if err != nil {
+		t.Fatalf("decoding the dashboard: %v", err)
+	}
+
+	state := JiraDashboardResourceModel{SharePermissions: configured}
+	if diags := setDashboardState(ctx, &state, dashboard); diags.HasError() {
+		t.Fatalf("setting the state: %v", diags)
+	}
+
+	if !state.SharePermissions.Equal(configured) {
+		t.Errorf("expected the share permissions read from Jira to equal the configured ones\nconfigured: %s\nread:       %s", configured, state.SharePermissions)
+	}
+	if state.ViewURL.ValueString() != "https://example.atlassian.net/jira/dashboards/10042" {
+		t.Errorf("unexpected view URL: %s", state.ViewURL)
+	}
+
+	// The share permissions sent back to Jira carry no ID.
+	sent, diags := dashboardFromModel(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("building the dashboard: %v", diags)
+	}
+	for _, permission := range sent.SharePermissions {
+		if permission.ID != 0 {
+			t.Errorf("expected no ID to be sent for the %s share permission, got: %d", permission.Type, permission.ID)
+		}
+	}
+}
+
+func TestDashboardFromModel_NoSharePermissions(t *testing.T) {
+	state := JiraDashboardResourceModel{
+		Name:             types.StringValue("Private"),
+		Description:      types.StringNull(),
+		SharePermissions: types.SetNull(types.ObjectType{AttrTypes: sharePermissionAttrTypes}),
+	}
+
+	dashboard, diags := dashboardFromModel(context.Background(), &state)
+	if diags.HasError() {
+		t.Fatalf("building the dashboard: %v", diags)
+	}
+
+	body, err := json.Marshal(dashboard)
+	if err != nil {
+		t.Fatalf("encoding the dashboard: %v", err)
+	}
+
+	// Jira requires the share permissions, so a private dashboard sends an empty list rather than none.
+	var decoded map[string]interface{}
+	_ = json.Unmarshal(body, &decoded)
+	if permissions, ok := decoded["sharePermissions"].([]interface{}); !ok || len(permissions) != 0 {
+		t.Errorf("expected an empty list of share permissions, got: %s", body)
+	}
+}
+
+func TestSetDashboardState_EmptySharePermissions(t *testing.T) {
+	ctx := context.Background()
+	dashboard := &dashboardDetails{ID: "10042", Name: "Private", SharePermissions: []sharePermission{}}
+
+	// Configuring no share permission as an empty set keeps it, rather than turning it into null and failing the apply.
+	empty := types.SetValueMust(types.ObjectType{AttrTypes: sharePermissionAttrTypes}, nil)
+	state := JiraDashboardResourceModel{SharePermissions: empty}
+	if diags := setDashboardState(ctx, &state, dashboard); diags.HasError() {
+		t.Fatalf("setting the state: %v", diags)
+	}
+	if !state.SharePermissions.Equal(empty) {
+		t.Errorf("expected the empty share permissions to be kept, got: %s", state.SharePermissions)
+	}
+
+	state = JiraDashboardResourceModel{SharePermissions: types.SetNull(types.ObjectType{AttrTypes: sharePermissionAttrTypes})}
+	if diags := setDashboardState(ctx, &state, dashboard); diags.HasError() {
+		t.Fatalf("setting the state: %v", diags)
+	}
+	if !state.SharePermissions.IsNull() {
+		t.Errorf("expected no share permission to stay null, got: %s", state.SharePermissions)
+	}
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// dataSourceHarness reads a data source in-process, the way Terraform would over the plugin protocol.
+// Configs are given as the model structs of the data source, with the computed attributes set to null.
+type dataSourceHarness struct {
+	t          *testing.T
+	ctx        context.Context
+	dataSource datasource.DataSource
+	schema     schema.Schema
+}
+
+// newDataSourceHarness returns a harness for the data source, configured with the given provider data.
+func newDataSourceHarness(t *testing.T, d datasource.DataSource, providerData *JiraCloudProviderData) *dataSourceHarness {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("getting the schema: %v", schemaResp.Diagnostics)
+	}
+
+	if configurable, ok := d.(datasource.DataSourceWithConfigure); ok {
+		resp := datasource.ConfigureResponse{}
+		configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: providerData}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configuring the data source: %v", resp.Diagnostics)
+		}
+	}
+
+	return &dataSourceHarness{t: t, ctx: ctx, dataSource: d, schema: schemaResp.Schema}
+}
+
+// config converts a model into the configuration Terraform would send for it.
+func (h *dataSourceHarness) config(model interface{}) tfsdk.Config {
+	h.t.Helper()
+
+	state := tfsdk.State{Schema: h.schema}
+	if diags := state.Set(h.ctx, model); diags.HasError() {
+		h.t.Fatalf("converting %T: %v", model, diags)
+	}
+
+	return tfsdk.Config{Schema: h.schema, Raw: state.Raw}
+}
+
+// validate runs the validators of the top-level attributes and the config validation of the data source, if it has one.
+func (h *dataSourceHarness) validate(config interface{}) diag.Diagnostics {
+	h.t.Helper()
+
+	tfConfig := h.config(config)
+
+	var diags diag.Diagnostics
+	for name, attribute := range h.schema.Attributes {
+		diags.Append(validateAttribute(h.ctx, tfConfig, name, attribute)...)
+	}
+
+	validator, ok := h.dataSource.(datasource.DataSourceWithValidateConfig)
+	if !ok {
+		return diags
+	}
+
+	resp := datasource.ValidateConfigResponse{}
+	validator.ValidateConfig(h.ctx, datasource.ValidateConfigRequest{Config: tfConfig}, &resp)
+	diags.Append(resp.Diagnostics...)
+
+	return diags
+}
+
+// tryRead reads the data source with the given config into the model, unless it fails.
+func (h *dataSourceHarness) tryRead(config interface{}, model interface{}) diag.Diagnostics {
+	h.t.Helper()
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: h.schema, Raw: tftypes.NewValue(h.schema.Type().TerraformType(h.ctx), nil)},
+	}
+	h.dataSource.Read(h.ctx, datasource.ReadRequest{Config: h.config(config)}, &resp)
+	if resp.Diagnostics.HasError() {
+		return resp.Diagnostics
+	}
+
+	if diags := resp.State.Get(h.ctx, model); diags.HasError() {
+		h.t.Fatalf("converting the state to %T: %v", model, diags)
+	}
+
+	return resp.Diagnostics
+}
+
+// read reads the data source with the given config into the model.
+func (h *dataSourceHarness) read(config interface{}, model interface{}) {
+	h.t.Helper()
+
+	if diags := h.tryRead(config, model); diags.HasError() {
+		h.t.Fatalf("read: %v", diags)
+	}
+}
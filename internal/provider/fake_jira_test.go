@@ -0,0 +1,1601 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"terraform-provider-jiracloud/internal/adf"
+)
+
+// fakeJiraAPIVersion matches the version of the Jira REST API in a request path,
+// since go-jira calls some endpoints through the version 2 and the provider others through the version 3.
+var fakeJiraAPIVersion = regexp.MustCompile(`^/rest/api/[23]/`)
+
+// fakeProjectKey matches the project keys Jira accepts with its default key format.
+var fakeProjectKey = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// fakeJQL matches the only JQL queries the fake Jira understands, e.g. `project = ABC ORDER BY created DESC`.
+var fakeJQL = regexp.MustCompile(`^project\s*=\s*"?([A-Z][A-Z0-9]*)"?(\s+ORDER BY\s+\w+(\s+(ASC|DESC))?)?$`)
+
+// fakeJira is an in-memory Jira Cloud instance for tests.
+// It serves the project, component and user endpoints from its own state,
+// and any other endpoint from the canned responses registered with respond or handle.
+type fakeJira struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	nextID     int
+	projects   []*fakeProject
+	components map[string]*jira.ProjectComponent
+	issues     []*fakeIssue
+	filters    map[string]*filterDetails
+	categories map[string]*projectCategoryDetails
+	versions   map[string]*jira.Version
+	groups     []*fakeGroup
+	// roleActors are the actors of the roles of the projects, by project key and role ID.
+	roleActors map[string][]fakeRoleActor
+	webhooks   []*webhookDetails
+	users      map[string]jira.User
+	// unassignable are the account IDs of the users issues can't be assigned to in any project.
+	unassignable map[string]bool
+	handlers     map[string]http.HandlerFunc
+	calls        map[string]int
+}
+
+// fakeProject is a project of the fake Jira.
+type fakeProject struct {
+	ID              string                  `json:"id"`
+	Key             string                  `json:"key"`
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description"`
+	ProjectTypeKey  string                  `json:"projectTypeKey"`
+	Lead            jira.User               `json:"lead"`
+	AssigneeType    string                  `json:"assigneeType"`
+	ProjectCategory *projectCategoryDetails `json:"projectCategory,omitempty"`
+}
+
+// fakeGroup is a group of the fake Jira.
+type fakeGroup struct {
+	ID      string
+	Name    string
+	Members []string
+}
+
+// fakeCurrentUser is the user the provider authenticates as against the fake Jira.
+var fakeCurrentUser = jira.User{
+	AccountID:    "557058:terraform",
+	EmailAddress: "terraform@example.com",
+	DisplayName:  "Terraform",
+	Active:       true,
+	TimeZone:     "Europe/Berlin",
+}
+
+// fakeIssueTypes are the issue types of every project of the fake Jira, by name.
+var fakeIssueTypes = map[string]issueTypeDetails{
+	"Epic":     {ID: "10000", Name: "Epic", HierarchyLevel: 1},
+	"Story":    {ID: "10001", Name: "Story"},
+	"Task":     {ID: "10002", Name: "Task"},
+	"Sub-task": {ID: "10003", Name: "Sub-task", Subtask: true, HierarchyLevel: -1},
+}
+
+// fakePriorities are the priorities of the fake Jira, in the order Jira lists them.
+var fakePriorities = []jira.Priority{
+	{ID: "1", Name: "Highest"},
+	{ID: "2", Name: "High"},
+	{ID: "3", Name: "Medium"},
+	{ID: "4", Name: "Low"},
+	{ID: "5", Name: "Lowest"},
+}
+
+// fakeRoles are the names of the roles every project of the fake Jira has, by ID.
+var fakeRoles = map[string]string{
+	"10002": "Administrators",
+	"10101": "Developers",
+}
+
+// fakeRoleActor is a user or a group acting in a role of a project of the fake Jira.
+type fakeRoleActor struct {
+	// Type is either userRoleActorType or groupRoleActorType.
+	Type string
+	// ID is the account ID of the user or the ID of the group.
+	ID string
+}
+
+// fakeIssue is an issue of the fake Jira.
+type fakeIssue struct {
+	ID     string          `json:"id"`
+	Key    string          `json:"key"`
+	Fields fakeIssueFields `json:"fields"`
+}
+
+// fakeIssueFields are the fields of an issue the fake Jira keeps track of.
+type fakeIssueFields struct {
+	Project     jira.Project     `json:"project"`
+	IssueType   issueTypeDetails `json:"issuetype"`
+	Summary     string           `json:"summary"`
+	Description string           `json:"description,omitempty"`
+	Labels      []string         `json:"labels"`
+	Priority    *jira.Priority   `json:"priority,omitempty"`
+	Parent      *jira.Parent     `json:"parent,omitempty"`
+
+	// descriptionADF is the description as the version 3 of the API returns it.
+	descriptionADF *adf.Node
+}
+
+// fakeJiraError is the body Jira answers failed requests with.
+type fakeJiraError struct {
+	ErrorMessages []string          `json:"errorMessages"`
+	Errors        map[string]string `json:"errors"`
+}
+
+// newFakeJira starts a fake Jira that is stopped at the end of the test.
+func newFakeJira(t *testing.T) *fakeJira {
+	t.Helper()
+
+	f := &fakeJira{
+		nextID:       10000,
+		components:   make(map[string]*jira.ProjectComponent),
+		filters:      make(map[string]*filterDetails),
+		categories:   make(map[string]*projectCategoryDetails),
+		versions:     make(map[string]*jira.Version),
+		roleActors:   make(map[string][]fakeRoleActor),
+		users:        map[string]jira.User{fakeCurrentUser.AccountID: fakeCurrentUser},
+		unassignable: make(map[string]bool),
+		handlers:     make(map[string]http.HandlerFunc),
+		calls:        make(map[string]int),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.Server.Close)
+
+	return f
+}
+
+// addProject creates a project led by a new user.
+func (f *fakeJira) addProject(key, name string) *fakeProject {
+	lead := f.addUser("lead-of-"+strings.ToLower(key), strings.ToLower(key)+"-lead@example.com", name+" Lead")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	project := &fakeProject{
+		ID:             f.newID(),
+		Key:            key,
+		Name:           name,
+		ProjectTypeKey: "software",
+		Lead:           lead,
+		AssigneeType:   "UNASSIGNED",
+	}
+	f.projects = append(f.projects, project)
+
+	return project
+}
+
+// addUser creates an active user.
+func (f *fakeJira) addUser(accountID, email, displayName string) jira.User {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user := jira.User{
+		AccountID:    accountID,
+		EmailAddress: email,
+		DisplayName:  displayName,
+		Active:       true,
+		TimeZone:     "Europe/Berlin",
+	}
+	f.users[accountID] = user
+
+	return user
+}
+
+// addGroup creates a group with the given members.
+func (f *fakeJira) addGroup(name string, members ...string) *fakeGroup {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	group := &fakeGroup{ID: "group-" + f.newID(), Name: name, Members: members}
+	f.groups = append(f.groups, group)
+
+	return group
+}
+
+// addRoleActor adds a user or a group to a role of the project, e.g. as if it was added outside of Terraform.
+func (f *fakeJira) addRoleActor(projectKey, roleID, actorType, id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.roleActors[projectKey+"/"+roleID] = append(f.roleActors[projectKey+"/"+roleID], fakeRoleActor{Type: actorType, ID: id})
+}
+
+// removeRoleActor removes a user or a group from a role of the project, e.g. as if it was removed outside of Terraform.
+func (f *fakeJira) removeRoleActor(projectKey, roleID, id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	actors := f.roleActors[projectKey+"/"+roleID]
+	for i, actor := range actors {
+		if actor.ID == id {
+			f.roleActors[projectKey+"/"+roleID] = append(actors[:i], actors[i+1:]...)
+			return
+		}
+	}
+}
+
+// projectRoleActors returns the IDs of the users and groups acting in a role of the project.
+func (f *fakeJira) projectRoleActors(projectKey, roleID string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := []string{}
+	for _, actor := range f.roleActors[projectKey+"/"+roleID] {
+		ids = append(ids, actor.ID)
+	}
+
+	return ids
+}
+
+// setUnassignable makes the user impossible to assign issues to, e.g. as if they lacked the Assignable User permission.
+func (f *fakeJira) setUnassignable(accountID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.unassignable[accountID] = true
+}
+
+// addComponent creates a component with the project default assignee, as if it was created outside of Terraform.
+func (f *fakeJira) addComponent(projectKey, name string) *jira.ProjectComponent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	project := f.project(projectKey)
+	if project == nil {
+		panic("fake Jira has no project " + projectKey)
+	}
+
+	component := &jira.ProjectComponent{
+		ID:           f.newID(),
+		Name:         name,
+		AssigneeType: "PROJECT_DEFAULT",
+		Project:      project.Key,
+	}
+	f.setComponentProject(component, project)
+	f.components[component.ID] = component
+
+	return component
+}
+
+// addIssue creates an issue of the given type, with no parent when parentKey is empty.
+func (f *fakeJira) addIssue(projectKey, issueType, summary, parentKey string) *fakeIssue {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fields := map[string]interface{}{
+		"project":   map[string]interface{}{"key": projectKey},
+		"issuetype": map[string]interface{}{"name": issueType},
+		"summary":   summary,
+	}
+	if parentKey != "" {
+		fields["parent"] = map[string]interface{}{"key": parentKey}
+	}
+
+	issue, err := f.newIssue(fields)
+	if err != nil {
+		panic(err)
+	}
+
+	return issue
+}
+
+// setWebhookExpiration changes when a webhook expires, as if time passed.
+func (f *fakeJira) setWebhookExpiration(id int64, expiration time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, webhook := range f.webhooks {
+		if webhook.ID == id {
+			webhook.ExpirationDate = expiration.UnixMilli()
+		}
+	}
+}
+
+// forgetWebhook removes a webhook, as Jira does some time after it expired.
+func (f *fakeJira) forgetWebhook(id int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.removeWebhook(id)
+}
+
+// deleteComponent deletes a component, as if it was deleted outside of Terraform.
+func (f *fakeJira) deleteComponent(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.components, id)
+}
+
+// respond registers a canned JSON response for the given method and path, taking precedence over the built-in endpoints.
+// The path includes the API version, e.g. `/rest/api/3/priority`.
+func (f *fakeJira) respond(method, path string, status int, body interface{}) {
+	f.handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, status, body)
+	})
+}
+
+// handle registers a handler for the given method and path, taking precedence over the built-in endpoints.
+func (f *fakeJira) handle(method, path string, handler http.HandlerFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.handlers[method+" "+path] = handler
+}
+
+// callCount returns how many requests the fake received for the given method and path.
+func (f *fakeJira) callCount(method, path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[method+" "+path]
+}
+
+func (f *fakeJira) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.calls[r.Method+" "+r.URL.Path]++
+	handler, found := f.handlers[r.Method+" "+r.URL.Path]
+	f.mu.Unlock()
+
+	if found {
+		handler(w, r)
+		return
+	}
+
+	if !fakeJiraAPIVersion.MatchString(r.URL.Path) {
+		writeJiraError(w, http.StatusNotFound, "No handler for "+r.Method+" "+r.URL.Path)
+		return
+	}
+
+	parts := strings.Split(fakeJiraAPIVersion.ReplaceAllString(r.URL.Path, ""), "/")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case parts[0] == "project" && len(parts) == 1 && r.Method == http.MethodPost:
+		f.createProject(w, r)
+	case parts[0] == "project" && len(parts) == 2 && r.Method == http.MethodGet:
+		f.getProject(w, parts[1])
+	case parts[0] == "project" && len(parts) == 2 && r.Method == http.MethodPut:
+		f.updateProject(w, r, parts[1])
+	case parts[0] == "project" && len(parts) == 2 && r.Method == http.MethodDelete:
+		f.deleteProject(w, parts[1])
+	case parts[0] == "project" && len(parts) == 3 && parts[2] == "components" && r.Method == http.MethodGet:
+		f.getProjectComponents(w, parts[1])
+	case parts[0] == "project" && len(parts) == 3 && parts[2] == "role" && r.Method == http.MethodGet:
+		f.listProjectRoles(w, parts[1])
+	case parts[0] == "project" && len(parts) == 4 && parts[2] == "role" && r.Method == http.MethodGet:
+		f.getProjectRole(w, parts[1], parts[3])
+	case parts[0] == "project" && len(parts) == 4 && parts[2] == "role" && r.Method == http.MethodPost:
+		f.addProjectRoleActors(w, r, parts[1], parts[3])
+	case parts[0] == "project" && len(parts) == 4 && parts[2] == "role" && r.Method == http.MethodDelete:
+		f.removeProjectRoleActor(w, r.URL.Query(), parts[1], parts[3])
+	case parts[0] == "component" && len(parts) == 1 && r.Method == http.MethodPost:
+		f.createComponent(w, r)
+	case parts[0] == "component" && len(parts) == 2 && r.Method == http.MethodGet:
+		f.getComponent(w, parts[1])
+	case parts[0] == "component" && len(parts) == 2 && r.Method == http.MethodPut:
+		f.updateComponent(w, r, parts[1])
+	case parts[0] == "component" && len(parts) == 2 && r.Method == http.MethodDelete:
+		f.deleteComponentRequest(w, parts[1])
+	case parts[0] == "issue" && len(parts) == 1 && r.Method == http.MethodPost:
+		f.createIssue(w, r)
+	case parts[0] == "issue" && len(parts) == 2 && r.Method == http.MethodGet:
+		f.getIssue(w, r, parts[1])
+	case parts[0] == "issue" && len(parts) == 2 && r.Method == http.MethodPut:
+		f.editIssue(w, r, parts[1])
+	case parts[0] == "issue" && len(parts) == 2 && r.Method == http.MethodDelete:
+		f.deleteIssue(w, parts[1])
+	case parts[0] == "filter" && len(parts) == 1 && r.Method == http.MethodPost:
+		f.createFilter(w, r)
+	case parts[0] == "filter" && len(parts) == 2 && r.Method == http.MethodGet:
+		f.getFilter(w, parts[1])
+	case parts[0] == "filter" && len(parts) == 2 && r.Method == http.MethodPut:
+		f.updateFilter(w, r, parts[1])
+	case parts[0] == "filter" && len(parts) == 2 && r.Method == http.MethodDelete:
+		f.deleteFilter(w, parts[1])
+	case parts[0] == "filter" && len(parts) == 3 && parts[2] == "favourite" && (r.Method == http.MethodPut || r.Method == http.MethodDelete):
+		f.setFilterFavourite(w, parts[1], r.Method == http.MethodPut)
+	case parts[0] == "webhook" && len(parts) == 1 && r.Method == http.MethodPost:
+		f.registerWebhooks(w, r)
+	case parts[0] == "webhook" && len(parts) == 1 && r.Method == http.MethodGet:
+		f.listWebhooks(w, r)
+	case parts[0] == "webhook" && len(parts) == 1 && r.Method == http.MethodDelete:
+		f.deleteWebhooks(w, r)
+	case parts[0] == "webhook" && len(parts) == 2 && parts[1] == "refresh" && r.Method == http.MethodPut:
+		f.refreshWebhooks(w, r)
+	case parts[0] == "projectCategory" && len(parts) == 1 && r.Method == http.MethodGet:
+		f.listProjectCategories(w)
+	case parts[0] == "projectCategory" && len(parts) == 1 && r.Method == http.MethodPost:
+		f.saveProjectCategory(w, r, f.newID())
+	case parts[0] == "projectCategory" && len(parts) == 2 && r.Method == http.MethodGet:
+		f.getProjectCategory(w, parts[1])
+	case parts[0] == "projectCategory" && len(parts) == 2 && r.Method == http.MethodPut:
+		f.saveProjectCategory(w, r, parts[1])
+	case parts[0] == "projectCategory" && len(parts) == 2 && r.Method == http.MethodDelete:
+		f.deleteProjectCategory(w, parts[1])
+	case parts[0] == "version" && len(parts) == 1 && r.Method == http.MethodPost:
+		f.createVersion(w, r)
+	case parts[0] == "version" && len(parts) == 2 && r.Method == http.MethodGet:
+		f.getVersion(w, parts[1])
+	case parts[0] == "version" && len(parts) == 2 && r.Method == http.MethodPut:
+		f.updateVersion(w, r, parts[1])
+	case parts[0] == "version" && len(parts) == 3 && parts[2] == "removeAndSwap" && r.Method == http.MethodPost:
+		f.deleteVersion(w, parts[1])
+	case parts[0] == "priority" && len(parts) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, fakePriorities)
+	case parts[0] == "priority" && len(parts) == 2 && parts[1] == "search" && r.Method == http.MethodGet:
+		f.searchPriorities(w, r.URL.Query())
+	case parts[0] == "group" && len(parts) == 2 && parts[1] == "member" && r.Method == http.MethodGet:
+		f.listGroupMembers(w, r.URL.Query())
+	case parts[0] == "group" && len(parts) == 2 && parts[1] == "user" && r.Method == http.MethodPost:
+		f.addGroupMember(w, r)
+	case parts[0] == "group" && len(parts) == 2 && parts[1] == "user" && r.Method == http.MethodDelete:
+		f.removeGroupMember(w, r.URL.Query())
+	case parts[0] == "myself" && len(parts) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, fakeCurrentUser)
+	case parts[0] == "user" && len(parts) == 3 && parts[1] == "assignable" && parts[2] == "search" && r.Method == http.MethodGet:
+		f.searchAssignableUsers(w, r.URL.Query().Get("project"), r.URL.Query().Get("accountId"))
+	case parts[0] == "user" && len(parts) == 1 && r.Method == http.MethodGet:
+		f.getUser(w, r.URL.Query().Get("accountId"))
+	case parts[0] == "user" && len(parts) == 2 && parts[1] == "search" && r.Method == http.MethodGet:
+		f.searchUsers(w, r.URL.Query().Get("query"))
+	default:
+		writeJiraError(w, http.StatusNotFound, "No handler for "+r.Method+" "+r.URL.Path)
+	}
+}
+
+func (f *fakeJira) getProject(w http.ResponseWriter, keyOrID string) {
+	project := f.project(keyOrID)
+	if project == nil {
+		writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+keyOrID+"'.")
+		return
+	}
+
+	// The components embedded in the project don't include their lead.
+	components := []jira.ProjectComponent{}
+	for _, component := range f.projectComponents(project) {
+		components = append(components, jira.ProjectComponent{ID: component.ID, Name: component.Name, Description: component.Description})
+	}
+
+	writeJSON(w, http.StatusOK, struct {
+		*fakeProject
+		Components []jira.ProjectComponent `json:"components"`
+	}{project, components})
+}
+
+func (f *fakeJira) createProject(w http.ResponseWriter, r *http.Request) {
+	options := new(projectCreateOptions)
+	if err := json.NewDecoder(r.Body).Decode(options); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	switch {
+	case !fakeProjectKey.MatchString(options.Key):
+		writeJSON(w, http.StatusBadRequest, fakeJiraError{Errors: map[string]string{"projectKey": "Project keys must start with an uppercase letter, followed by one or more uppercase alphanumeric characters."}})
+		return
+	case f.project(options.Key) != nil:
+		writeJSON(w, http.StatusBadRequest, fakeJiraError{Errors: map[string]string{"projectKey": "Project '" + options.Key + "' uses this project key."}})
+		return
+	}
+
+	project := &fakeProject{
+		ID:             f.newID(),
+		Key:            options.Key,
+		Name:           options.Name,
+		Description:    options.Description,
+		ProjectTypeKey: options.ProjectTypeKey,
+		AssigneeType:   "UNASSIGNED",
+	}
+	if options.AssigneeType != "" {
+		project.AssigneeType = options.AssigneeType
+	}
+	if err := f.setProjectLead(project, options.LeadAccountId); err != nil {
+		writeJSON(w, http.StatusBadRequest, err)
+		return
+	}
+	if options.CategoryID != 0 {
+		if err := f.setProjectCategory(project, options.CategoryID); err != nil {
+			writeJSON(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	f.projects = append(f.projects, project)
+
+	writeJSON(w, http.StatusCreated, projectCreated{ID: json.Number(project.ID), Key: project.Key})
+}
+
+func (f *fakeJira) updateProject(w http.ResponseWriter, r *http.Request, keyOrID string) {
+	project := f.project(keyOrID)
+	if project == nil {
+		writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+keyOrID+"'.")
+		return
+	}
+
+	options := new(projectUpdateOptions)
+	if err := json.NewDecoder(r.Body).Decode(options); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Like Jira, the fake validates the whole update before applying any of it.
+	updated := *project
+	if options.LeadAccountId != "" {
+		if err := f.setProjectLead(&updated, options.LeadAccountId); err != nil {
+			writeJSON(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	if options.CategoryID != nil {
+		if err := f.setProjectCategory(&updated, *options.CategoryID); err != nil {
+			writeJSON(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	if options.Name != "" {
+		updated.Name = options.Name
+	}
+	if options.AssigneeType != "" {
+		updated.AssigneeType = options.AssigneeType
+	}
+	updated.Description = options.Description
+	*project = updated
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (f *fakeJira) deleteProject(w http.ResponseWriter, keyOrID string) {
+	for i, project := range f.projects {
+		if project.Key == keyOrID || project.ID == keyOrID {
+			f.projects = append(f.projects[:i], f.projects[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+keyOrID+"'.")
+}
+
+// setProjectLead makes the user the lead of the project, which Jira only accepts for users issues can be assigned to.
+func (f *fakeJira) setProjectLead(project *fakeProject, accountID string) *fakeJiraError {
+	lead, found := f.users[accountID]
+	if !found {
+		return &fakeJiraError{Errors: map[string]string{"projectLead": "The project lead you specified does not exist."}}
+	}
+	if f.unassignable[accountID] {
+		return &fakeJiraError{Errors: map[string]string{"projectLead": "The project lead must have the Assignable User permission."}}
+	}
+
+	project.Lead = lead
+	return nil
+}
+
+// setProjectCategory puts the project in the category with the given ID, or removes it from its category for -1.
+func (f *fakeJira) setProjectCategory(project *fakeProject, categoryID int64) *fakeJiraError {
+	if categoryID == projectRemoveCategory {
+		project.ProjectCategory = nil
+		return nil
+	}
+
+	category, found := f.categories[strconv.FormatInt(categoryID, 10)]
+	if !found {
+		return &fakeJiraError{Errors: map[string]string{"categoryId": "The project category does not exist."}}
+	}
+
+	project.ProjectCategory = category
+	return nil
+}
+
+func (f *fakeJira) searchAssignableUsers(w http.ResponseWriter, projectKey, accountID string) {
+	if f.project(projectKey) == nil {
+		writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+projectKey+"'.")
+		return
+	}
+
+	users := []jira.User{}
+	if user, found := f.users[accountID]; found && !f.unassignable[accountID] {
+		users = append(users, user)
+	}
+
+	writeJSON(w, http.StatusOK, users)
+}
+
+func (f *fakeJira) getProjectComponents(w http.ResponseWriter, keyOrID string) {
+	project := f.project(keyOrID)
+	if project == nil {
+		writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+keyOrID+"'.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, f.projectComponents(project))
+}
+
+func (f *fakeJira) createComponent(w http.ResponseWriter, r *http.Request) {
+	var options componentUpdateOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	project := f.project(options.Project)
+	if project == nil {
+		writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+options.Project+"'.")
+		return
+	}
+
+	if f.componentNamed(project, options.Name) != nil {
+		writeJSON(w, http.StatusBadRequest, fakeJiraError{
+			Errors: map[string]string{"name": fmt.Sprintf("A component with the name %s already exists in this project.", options.Name)},
+		})
+		return
+	}
+
+	component := &jira.ProjectComponent{ID: f.newID()}
+	f.setComponentProject(component, project)
+	f.setComponentFields(component, options)
+	f.components[component.ID] = component
+
+	writeJSON(w, http.StatusCreated, component)
+}
+
+func (f *fakeJira) getComponent(w http.ResponseWriter, id string) {
+	component, found := f.components[id]
+	if !found {
+		writeJiraError(w, http.StatusNotFound, "The component with id "+id+" does not exist.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, component)
+}
+
+func (f *fakeJira) updateComponent(w http.ResponseWriter, r *http.Request, id string) {
+	component, found := f.components[id]
+	if !found {
+		writeJiraError(w, http.StatusNotFound, "The component with id "+id+" does not exist.")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var options componentUpdateOptions
+	var sent map[string]json.RawMessage
+	if err := json.Unmarshal(body, &options); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	_ = json.Unmarshal(body, &sent)
+
+	// Like Jira, the fake only clears the description and the lead when the request sends empty ones,
+	// and keeps them when they are left out.
+	if _, hasDescription := sent["description"]; !hasDescription {
+		options.Description = component.Description
+	}
+	if _, hasLead := sent["leadAccountId"]; !hasLead {
+		options.LeadAccountId = component.Lead.AccountID
+	}
+
+	if other := f.componentNamed(f.project(component.Project), options.Name); other != nil && other.ID != id {
+		writeJSON(w, http.StatusBadRequest, fakeJiraError{
+			Errors: map[string]string{"name": fmt.Sprintf("A component with the name %s already exists in this project.", options.Name)},
+		})
+		return
+	}
+
+	f.setComponentFields(component, options)
+
+	writeJSON(w, http.StatusOK, component)
+}
+
+func (f *fakeJira) deleteComponentRequest(w http.ResponseWriter, id string) {
+	if _, found := f.components[id]; !found {
+		writeJiraError(w, http.StatusNotFound, "The component with id "+id+" does not exist.")
+		return
+	}
+
+	delete(f.components, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeJira) createIssue(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	issue, err := f.newIssue(body.Fields)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"id": issue.ID, "key": issue.Key})
+}
+
+func (f *fakeJira) getIssue(w http.ResponseWriter, r *http.Request, idOrKey string) {
+	issue := f.issue(idOrKey)
+	if issue == nil {
+		writeJiraError(w, http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+		return
+	}
+
+	// The version 3 of the API returns the description as an ADF document.
+	if strings.HasPrefix(r.URL.Path, "/rest/api/3/") {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":     issue.ID,
+			"key":    issue.Key,
+			"fields": map[string]interface{}{"issuetype": issue.Fields.IssueType, "description": issue.Fields.descriptionADF},
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, issue)
+}
+
+func (f *fakeJira) editIssue(w http.ResponseWriter, r *http.Request, idOrKey string) {
+	issue := f.issue(idOrKey)
+	if issue == nil {
+		writeJiraError(w, http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+		return
+	}
+
+	var body struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Validate the whole edit before applying any of it, like Jira does.
+	fields := issue.Fields
+	if err := f.setIssueFields(&fields, body.Fields); err != nil {
+		writeJSON(w, http.StatusBadRequest, err)
+		return
+	}
+
+	issue.Fields = fields
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeJira) deleteIssue(w http.ResponseWriter, idOrKey string) {
+	for i, issue := range f.issues {
+		if issue.ID == idOrKey || issue.Key == idOrKey {
+			f.issues = append(f.issues[:i], f.issues[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	writeJiraError(w, http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+}
+
+// newIssue creates an issue out of the fields of a create request.
+func (f *fakeJira) newIssue(requested map[string]interface{}) (*fakeIssue, *fakeJiraError) {
+	projectKey, _ := requested["project"].(map[string]interface{})["key"].(string)
+	project := f.project(projectKey)
+	if project == nil {
+		return nil, &fakeJiraError{Errors: map[string]string{"project": "valid project is required"}}
+	}
+
+	typeName, _ := requested["issuetype"].(map[string]interface{})["name"].(string)
+	issueType, found := fakeIssueTypes[typeName]
+	if !found {
+		return nil, &fakeJiraError{Errors: map[string]string{"issuetype": "valid issue type is required"}}
+	}
+
+	issue := &fakeIssue{
+		Fields: fakeIssueFields{
+			Project:   jira.Project{ID: project.ID, Key: project.Key, Name: project.Name},
+			IssueType: issueType,
+			// Like Jira, issues get the default priority unless they are created with another one.
+			Priority: &fakePriorities[2],
+		},
+	}
+	if err := f.setIssueFields(&issue.Fields, requested); err != nil {
+		return nil, err
+	}
+	if issueType.Subtask && issue.Fields.Parent == nil {
+		return nil, &fakeJiraError{Errors: map[string]string{"parent": "Given parent work item does not belong to appropriate hierarchy."}}
+	}
+
+	issue.ID = f.newID()
+	issue.Key = project.Key + "-" + issue.ID
+	f.issues = append(f.issues, issue)
+
+	return issue, nil
+}
+
+// setIssueFields applies the fields of a create or edit request, ignoring the fields the fake doesn't keep track of.
+func (f *fakeJira) setIssueFields(fields *fakeIssueFields, requested map[string]interface{}) *fakeJiraError {
+	for name, value := range requested {
+		switch name {
+		case "summary":
+			fields.Summary, _ = value.(string)
+		case "description":
+			fields.Description, fields.descriptionADF = "", nil
+			switch description := value.(type) {
+			case string:
+				fields.Description = description
+				if description != "" {
+					fields.descriptionADF = adf.FromText(description)
+				}
+			case map[string]interface{}:
+				// Like Jira, the fake stores documents as they are sent, which flattening may not restore exactly.
+				encoded, _ := json.Marshal(description)
+				fields.descriptionADF = new(adf.Node)
+				if err := json.Unmarshal(encoded, fields.descriptionADF); err != nil {
+					return &fakeJiraError{Errors: map[string]string{"description": "Operation value must be an Atlassian Document."}}
+				}
+				fields.Description = adf.ToText(fields.descriptionADF)
+			}
+		case "labels":
+			fields.Labels = nil
+			values, _ := value.([]interface{})
+			for _, label := range values {
+				fields.Labels = append(fields.Labels, label.(string))
+			}
+		case "priority":
+			id, _ := value.(map[string]interface{})["id"].(string)
+			fields.Priority = nil
+			for i := range fakePriorities {
+				if fakePriorities[i].ID == id {
+					fields.Priority = &fakePriorities[i]
+				}
+			}
+			if fields.Priority == nil {
+				return &fakeJiraError{Errors: map[string]string{"priority": "The priority selected is invalid."}}
+			}
+		case "parent":
+			if value == nil {
+				if fields.IssueType.Subtask {
+					return &fakeJiraError{Errors: map[string]string{"parent": "Given parent work item does not belong to appropriate hierarchy."}}
+				}
+				fields.Parent = nil
+				continue
+			}
+
+			parentKey, _ := value.(map[string]interface{})["key"].(string)
+			parent := f.issue(parentKey)
+			if parent == nil {
+				return &fakeJiraError{Errors: map[string]string{"parent": "Could not find issue by id or key."}}
+			}
+			if parent.Fields.IssueType.HierarchyLevel != fields.IssueType.HierarchyLevel+1 {
+				return &fakeJiraError{Errors: map[string]string{"parent": "Given parent work item does not belong to appropriate hierarchy."}}
+			}
+			fields.Parent = &jira.Parent{ID: parent.ID, Key: parent.Key}
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeJira) createFilter(w http.ResponseWriter, r *http.Request) {
+	filter := new(filterDetails)
+	if err := json.NewDecoder(r.Body).Decode(filter); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter.ID = f.newID()
+	if err := f.saveFilter(filter); err != nil {
+		writeJSON(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, filter)
+}
+
+func (f *fakeJira) getFilter(w http.ResponseWriter, id string) {
+	filter, found := f.filters[id]
+	if !found {
+		writeJiraError(w, http.StatusBadRequest, "The selected filter is not available to you, perhaps it has been deleted or had its permissions changed.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, filter)
+}
+
+func (f *fakeJira) updateFilter(w http.ResponseWriter, r *http.Request, id string) {
+	current, found := f.filters[id]
+	if !found {
+		writeJiraError(w, http.StatusBadRequest, "The selected filter is not available to you, perhaps it has been deleted or had its permissions changed.")
+		return
+	}
+
+	filter := new(filterDetails)
+	if err := json.NewDecoder(r.Body).Decode(filter); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Like Jira, the fake only changes the favourite flag through its own endpoint.
+	filter.ID = id
+	filter.Favourite = current.Favourite
+	if err := f.saveFilter(filter); err != nil {
+		writeJSON(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, filter)
+}
+
+func (f *fakeJira) deleteFilter(w http.ResponseWriter, id string) {
+	if _, found := f.filters[id]; !found {
+		writeJiraError(w, http.StatusNotFound, "The selected filter is not available to you, perhaps it has been deleted or had its permissions changed.")
+		return
+	}
+
+	delete(f.filters, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeJira) setFilterFavourite(w http.ResponseWriter, id string, favourite bool) {
+	filter, found := f.filters[id]
+	if !found {
+		writeJiraError(w, http.StatusBadRequest, "The selected filter is not available to you, perhaps it has been deleted or had its permissions changed.")
+		return
+	}
+
+	filter.Favourite = favourite
+	writeJSON(w, http.StatusOK, filter)
+}
+
+// saveFilter validates the filter the way Jira does and stores it, assigning IDs to its share permissions.
+func (f *fakeJira) saveFilter(filter *filterDetails) *fakeJiraError {
+	match := fakeJQL.FindStringSubmatch(filter.JQL)
+	if match == nil {
+		return &fakeJiraError{Errors: map[string]string{"jql": fmt.Sprintf("Error in the JQL Query: The query %q could not be parsed.", filter.JQL)}}
+	}
+	if f.project(match[1]) == nil {
+		return &fakeJiraError{Errors: map[string]string{"jql": fmt.Sprintf("The value '%s' does not exist for the field 'project'.", match[1])}}
+	}
+
+	for id, other := range f.filters {
+		if id != filter.ID && other.Name == filter.Name {
+			return &fakeJiraError{Errors: map[string]string{"filterName": "Filter with same name already exists."}}
+		}
+	}
+
+	for i := range filter.SharePermissions {
+		filter.SharePermissions[i].ID = int64(10000 + i)
+	}
+
+	filter.SearchURL = f.URL + "/rest/api/3/search?jql=" + url.QueryEscape(filter.JQL)
+	f.filters[filter.ID] = filter
+
+	return nil
+}
+
+func (f *fakeJira) registerWebhooks(w http.ResponseWriter, r *http.Request) {
+	registration := new(webhookRegistration)
+	if err := json.NewDecoder(r.Body).Decode(registration); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	results := []map[string]interface{}{}
+	for _, request := range registration.Webhooks {
+		if request.JQL == "" || len(request.Events) == 0 {
+			results = append(results, map[string]interface{}{"errors": []string{"The webhook needs a JQL filter and at least one event."}})
+			continue
+		}
+
+		id, _ := strconv.ParseInt(f.newID(), 10, 64)
+		f.webhooks = append(f.webhooks, &webhookDetails{
+			ID:             id,
+			JQL:            request.JQL,
+			Events:         request.Events,
+			ExpirationDate: time.Now().Add(30 * 24 * time.Hour).UnixMilli(),
+		})
+		results = append(results, map[string]interface{}{"createdWebhookId": id})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"webhookRegistrationResult": results})
+}
+
+func (f *fakeJira) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+	maxResults, err := strconv.Atoi(r.URL.Query().Get("maxResults"))
+	if err != nil {
+		maxResults = 100
+	}
+
+	page := webhooksPage{IsLast: true, Values: []webhookDetails{}}
+	for i := startAt; i < len(f.webhooks); i++ {
+		if len(page.Values) == maxResults {
+			page.IsLast = false
+			break
+		}
+		page.Values = append(page.Values, *f.webhooks[i])
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (f *fakeJira) refreshWebhooks(w http.ResponseWriter, r *http.Request) {
+	ids := new(webhookIDs)
+	if err := json.NewDecoder(r.Body).Decode(ids); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	expirationDate := time.Now().Add(30 * 24 * time.Hour).UnixMilli()
+	for _, id := range ids.WebhookIDs {
+		for _, webhook := range f.webhooks {
+			if webhook.ID == id {
+				webhook.ExpirationDate = expirationDate
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]int64{"expirationDate": expirationDate})
+}
+
+func (f *fakeJira) deleteWebhooks(w http.ResponseWriter, r *http.Request) {
+	ids := new(webhookIDs)
+	if err := json.NewDecoder(r.Body).Decode(ids); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, id := range ids.WebhookIDs {
+		f.removeWebhook(id)
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (f *fakeJira) removeWebhook(id int64) {
+	for i, webhook := range f.webhooks {
+		if webhook.ID == id {
+			f.webhooks = append(f.webhooks[:i], f.webhooks[i+1:]...)
+			return
+		}
+	}
+}
+
+func (f *fakeJira) listProjectCategories(w http.ResponseWriter) {
+	categories := []*projectCategoryDetails{}
+	for _, category := range f.categories {
+		categories = append(categories, category)
+	}
+
+	writeJSON(w, http.StatusOK, categories)
+}
+
+func (f *fakeJira) getProjectCategory(w http.ResponseWriter, id string) {
+	category, found := f.categories[id]
+	if !found {
+		writeJiraError(w, http.StatusNotFound, "The project category with ID "+id+" does not exist.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, category)
+}
+
+// saveProjectCategory creates or updates the project category with the given ID, which must have a unique name.
+// Like Jira, the fake only updates the attributes sent in the request.
+func (f *fakeJira) saveProjectCategory(w http.ResponseWriter, r *http.Request, id string) {
+	category, found := f.categories[id]
+	if r.Method == http.MethodPut && !found {
+		writeJiraError(w, http.StatusNotFound, "The project category with ID "+id+" does not exist.")
+		return
+	}
+	if !found {
+		category = &projectCategoryDetails{ID: id}
+	}
+
+	options := make(map[string]string)
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, hasName := options["name"]
+	if (!hasName && !found) || (hasName && name == "") {
+		writeJSON(w, http.StatusBadRequest, fakeJiraError{Errors: map[string]string{"name": "The project category name must be specified."}})
+		return
+	}
+	for otherID, other := range f.categories {
+		if hasName && otherID != id && other.Name == name {
+			writeJSON(w, http.StatusConflict, fakeJiraError{Errors: map[string]string{"name": "The project category '" + name + "' already exists."}})
+			return
+		}
+	}
+
+	if hasName {
+		category.Name = name
+	}
+	if description, hasDescription := options["description"]; hasDescription {
+		category.Description = description
+	}
+	f.categories[id] = category
+
+	status := http.StatusOK
+	if !found {
+		status = http.StatusCreated
+	}
+	writeJSON(w, status, category)
+}
+
+func (f *fakeJira) deleteProjectCategory(w http.ResponseWriter, id string) {
+	if _, found := f.categories[id]; !found {
+		writeJiraError(w, http.StatusNotFound, "The project category with ID "+id+" does not exist.")
+		return
+	}
+
+	delete(f.categories, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createVersion creates a version in the project with the numeric ID of the request.
+func (f *fakeJira) createVersion(w http.ResponseWriter, r *http.Request) {
+	version := new(jira.Version)
+	if err := json.NewDecoder(r.Body).Decode(version); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if f.project(strconv.Itoa(version.ProjectID)) == nil {
+		writeJSON(w, http.StatusBadRequest, fakeJiraError{Errors: map[string]string{"project": "Project must be specified to create a version."}})
+		return
+	}
+
+	version.ID = f.newID()
+	f.versions[version.ID] = version
+
+	writeJSON(w, http.StatusCreated, version)
+}
+
+func (f *fakeJira) getVersion(w http.ResponseWriter, id string) {
+	version, found := f.versions[id]
+	if !found {
+		writeJiraError(w, http.StatusNotFound, "Could not find version for id '"+id+"'")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, version)
+}
+
+// updateVersion replaces the attributes of the version, except for its project.
+func (f *fakeJira) updateVersion(w http.ResponseWriter, r *http.Request, id string) {
+	version, found := f.versions[id]
+	if !found {
+		writeJiraError(w, http.StatusNotFound, "Could not find version for id '"+id+"'")
+		return
+	}
+
+	// Like Jira, the fake only changes the attributes sent in the request, and clears those sent as null.
+	var requested map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&requested); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var attributes map[string]json.RawMessage
+	encoded, _ := json.Marshal(version)
+	_ = json.Unmarshal(encoded, &attributes)
+	for name, value := range requested {
+		if string(value) == "null" {
+			delete(attributes, name)
+			continue
+		}
+		attributes[name] = value
+	}
+
+	updated := new(jira.Version)
+	encoded, _ = json.Marshal(attributes)
+	if err := json.Unmarshal(encoded, updated); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updated.ID = version.ID
+	updated.ProjectID = version.ProjectID
+	f.versions[id] = updated
+
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (f *fakeJira) deleteVersion(w http.ResponseWriter, id string) {
+	if _, found := f.versions[id]; !found {
+		writeJiraError(w, http.StatusNotFound, "Could not find version for id '"+id+"'")
+		return
+	}
+
+	delete(f.versions, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// searchPriorities returns a page of the priorities, like the paginated priority search of Jira.
+func (f *fakeJira) searchPriorities(w http.ResponseWriter, query url.Values) {
+	startAt, _ := strconv.Atoi(query.Get("startAt"))
+	maxResults, err := strconv.Atoi(query.Get("maxResults"))
+	if err != nil || maxResults <= 0 {
+		maxResults = 50
+	}
+
+	values := []jira.Priority{}
+	if startAt < len(fakePriorities) {
+		values = fakePriorities[startAt:]
+	}
+	if len(values) > maxResults {
+		values = values[:maxResults]
+	}
+
+	writeJSON(w, http.StatusOK, prioritiesPage{
+		IsLast: startAt+len(values) >= len(fakePriorities),
+		Values: values,
+	})
+}
+
+// listGroupMembers returns a page of the members of the group, like the paginated group member endpoint of Jira.
+func (f *fakeJira) listGroupMembers(w http.ResponseWriter, query url.Values) {
+	group := f.group(query)
+	if group == nil {
+		writeJiraError(w, http.StatusNotFound, "Specified group does not exist.")
+		return
+	}
+
+	startAt, _ := strconv.Atoi(query.Get("startAt"))
+	maxResults, err := strconv.Atoi(query.Get("maxResults"))
+	if err != nil || maxResults <= 0 {
+		maxResults = 50
+	}
+
+	values := []jira.User{}
+	for i := startAt; i < len(group.Members) && len(values) < maxResults; i++ {
+		values = append(values, f.users[group.Members[i]])
+	}
+
+	writeJSON(w, http.StatusOK, groupMembersPage{
+		IsLast: startAt+len(values) >= len(group.Members),
+		Values: values,
+	})
+}
+
+func (f *fakeJira) addGroupMember(w http.ResponseWriter, r *http.Request) {
+	group := f.group(r.URL.Query())
+	if group == nil {
+		writeJiraError(w, http.StatusNotFound, "Specified group does not exist.")
+		return
+	}
+
+	var options groupMemberAddOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if _, found := f.users[options.AccountID]; !found {
+		writeJiraError(w, http.StatusBadRequest, "Specified user does not exist or you do not have required permissions")
+		return
+	}
+	for _, member := range group.Members {
+		if member == options.AccountID {
+			writeJiraError(w, http.StatusBadRequest, "Cannot add user. User is already a member of '"+group.Name+"'")
+			return
+		}
+	}
+
+	group.Members = append(group.Members, options.AccountID)
+	writeJSON(w, http.StatusCreated, map[string]string{"groupId": group.ID, "name": group.Name})
+}
+
+func (f *fakeJira) removeGroupMember(w http.ResponseWriter, query url.Values) {
+	group := f.group(query)
+	if group == nil {
+		writeJiraError(w, http.StatusNotFound, "Specified group does not exist.")
+		return
+	}
+
+	for i, member := range group.Members {
+		if member == query.Get("accountId") {
+			group.Members = append(group.Members[:i], group.Members[i+1:]...)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	writeJiraError(w, http.StatusNotFound, "Specified user is not a member of the group.")
+}
+
+func (f *fakeJira) listProjectRoles(w http.ResponseWriter, keyOrID string) {
+	project := f.project(keyOrID)
+	if project == nil {
+		writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+keyOrID+"'.")
+		return
+	}
+
+	// Jira maps the name of every role to its URL.
+	roles := map[string]string{}
+	for id, name := range fakeRoles {
+		roles[name] = fmt.Sprintf("%s/rest/api/3/project/%s/role/%s", f.URL, project.ID, id)
+	}
+
+	writeJSON(w, http.StatusOK, roles)
+}
+
+// projectRole returns the key under which the actors of the role of the project are kept,
+// or writes the Jira error when the project or the role doesn't exist.
+func (f *fakeJira) projectRole(w http.ResponseWriter, keyOrID, roleID string) (string, bool) {
+	project := f.project(keyOrID)
+	if project == nil {
+		writeJiraError(w, http.StatusNotFound, "No project could be found with key '"+keyOrID+"'.")
+		return "", false
+	}
+	if _, found := fakeRoles[roleID]; !found {
+		writeJiraError(w, http.StatusNotFound, "Could not find project role with id '"+roleID+"'.")
+		return "", false
+	}
+
+	return project.Key + "/" + roleID, true
+}
+
+func (f *fakeJira) getProjectRole(w http.ResponseWriter, keyOrID, roleID string) {
+	role, found := f.projectRole(w, keyOrID, roleID)
+	if !found {
+		return
+	}
+
+	actors := []map[string]interface{}{}
+	for _, actor := range f.roleActors[role] {
+		switch actor.Type {
+		case userRoleActorType:
+			actors = append(actors, map[string]interface{}{"type": actor.Type, "actorUser": map[string]string{"accountId": actor.ID}})
+		case groupRoleActorType:
+			actors = append(actors, map[string]interface{}{"type": actor.Type, "actorGroup": map[string]string{"groupId": actor.ID}})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"id": roleID, "name": fakeRoles[roleID], "actors": actors})
+}
+
+func (f *fakeJira) addProjectRoleActors(w http.ResponseWriter, r *http.Request, keyOrID, roleID string) {
+	role, found := f.projectRole(w, keyOrID, roleID)
+	if !found {
+		return
+	}
+
+	var options projectRoleActorsAddOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		writeJiraError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Validate every actor before adding any of them, like Jira does.
+	actors := make([]fakeRoleActor, 0, len(options.User)+len(options.GroupID))
+	for _, accountID := range options.User {
+		if _, found := f.users[accountID]; !found {
+			writeJiraError(w, http.StatusBadRequest, "We couldn't find the user with account ID '"+accountID+"'.")
+			return
+		}
+		actors = append(actors, fakeRoleActor{Type: userRoleActorType, ID: accountID})
+	}
+	for _, groupID := range options.GroupID {
+		if f.group(url.Values{"groupId": {groupID}}) == nil {
+			writeJiraError(w, http.StatusBadRequest, "We couldn't find the group with ID '"+groupID+"'.")
+			return
+		}
+		actors = append(actors, fakeRoleActor{Type: groupRoleActorType, ID: groupID})
+	}
+	for _, actor := range actors {
+		for _, existing := range f.roleActors[role] {
+			if existing.ID == actor.ID {
+				writeJiraError(w, http.StatusBadRequest, "'"+actor.ID+"' is already a member of the project role.")
+				return
+			}
+		}
+	}
+
+	f.roleActors[role] = append(f.roleActors[role], actors...)
+	f.getProjectRole(w, keyOrID, roleID)
+}
+
+func (f *fakeJira) removeProjectRoleActor(w http.ResponseWriter, query url.Values, keyOrID, roleID string) {
+	role, found := f.projectRole(w, keyOrID, roleID)
+	if !found {
+		return
+	}
+
+	id := query.Get("user")
+	if id == "" {
+		id = query.Get("groupId")
+	}
+
+	for i, actor := range f.roleActors[role] {
+		if actor.ID == id {
+			f.roleActors[role] = append(f.roleActors[role][:i], f.roleActors[role][i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	writeJiraError(w, http.StatusNotFound, "'"+id+"' is not a member of the project role.")
+}
+
+func (f *fakeJira) getUser(w http.ResponseWriter, accountID string) {
+	user, found := f.users[accountID]
+	if !found {
+		writeJiraError(w, http.StatusNotFound, "Specified user does not exist or you do not have required permissions")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (f *fakeJira) searchUsers(w http.ResponseWriter, query string) {
+	users := []jira.User{}
+	for _, user := range f.users {
+		if strings.Contains(strings.ToLower(user.EmailAddress), strings.ToLower(query)) ||
+			strings.Contains(strings.ToLower(user.DisplayName), strings.ToLower(query)) {
+			users = append(users, user)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, users)
+}
+
+// project returns the project with the given key or ID, or nil.
+func (f *fakeJira) project(keyOrID string) *fakeProject {
+	for _, project := range f.projects {
+		if project.Key == keyOrID || project.ID == keyOrID {
+			return project
+		}
+	}
+
+	return nil
+}
+
+// group returns the group identified by the groupId or groupname query parameter, or nil.
+func (f *fakeJira) group(query url.Values) *fakeGroup {
+	for _, group := range f.groups {
+		if (query.Has("groupId") && group.ID == query.Get("groupId")) || (!query.Has("groupId") && group.Name == query.Get("groupname")) {
+			return group
+		}
+	}
+
+	return nil
+}
+
+// issue returns the issue with the given key or ID, or nil.
+func (f *fakeJira) issue(idOrKey string) *fakeIssue {
+	for _, issue := range f.issues {
+		if issue.ID == idOrKey || issue.Key == idOrKey {
+			return issue
+		}
+	}
+
+	return nil
+}
+
+// projectComponents returns the components of the project in creation order.
+func (f *fakeJira) projectComponents(project *fakeProject) []*jira.ProjectComponent {
+	components := []*jira.ProjectComponent{}
+	for _, component := range f.components {
+		if component.Project == project.Key {
+			components = append(components, component)
+		}
+	}
+
+	sortComponentsByID(components)
+
+	return components
+}
+
+// componentNamed returns the component of the project with the given name, or nil.
+func (f *fakeJira) componentNamed(project *fakeProject, name string) *jira.ProjectComponent {
+	for _, component := range f.projectComponents(project) {
+		if component.Name == name {
+			return component
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeJira) setComponentProject(component *jira.ProjectComponent, project *fakeProject) {
+	projectID, _ := strconv.Atoi(project.ID)
+	component.Project = project.Key
+	component.ProjectID = projectID
+	f.setRealAssignee(component, project)
+}
+
+// setComponentFields applies the options of a create or update request, resolving the assignee the way Jira does.
+func (f *fakeJira) setComponentFields(component *jira.ProjectComponent, options componentUpdateOptions) {
+	component.Name = options.Name
+	component.Description = options.Description
+	if options.AssigneeType != "" {
+		component.AssigneeType = options.AssigneeType
+	}
+	if component.AssigneeType == "" {
+		component.AssigneeType = "PROJECT_DEFAULT"
+	}
+
+	component.Lead = jira.User{}
+	if options.LeadAccountId != "" {
+		component.Lead = f.users[options.LeadAccountId]
+		component.Lead.AccountID = options.LeadAccountId
+	}
+
+	f.setRealAssignee(component, f.project(component.Project))
+}
+
+// setRealAssignee resolves the effective assignee of the component.
+// The project default assignee of the fake is always the project lead.
+func (f *fakeJira) setRealAssignee(component *jira.ProjectComponent, project *fakeProject) {
+	switch {
+	case component.AssigneeType == "UNASSIGNED":
+		component.RealAssigneeType = "UNASSIGNED"
+		component.RealAssignee = jira.User{}
+	case component.AssigneeType == "COMPONENT_LEAD" && component.Lead.AccountID != "":
+		component.RealAssigneeType = "COMPONENT_LEAD"
+		component.RealAssignee = component.Lead
+	default:
+		component.RealAssigneeType = "PROJECT_LEAD"
+		component.RealAssignee = project.Lead
+	}
+
+	component.IsAssigneeTypeValid = component.RealAssigneeType == component.AssigneeType
+}
+
+func (f *fakeJira) newID() string {
+	f.nextID++
+	return strconv.Itoa(f.nextID)
+}
+
+// sortComponentsByID sorts components by their numeric ID, i.e. in creation order.
+func sortComponentsByID(components []*jira.ProjectComponent) {
+	for i := 1; i < len(components); i++ {
+		for j := i; j > 0 && componentIDLess(components[j], components[j-1]); j-- {
+			components[j], components[j-1] = components[j-1], components[j]
+		}
+	}
+}
+
+func componentIDLess(a, b *jira.ProjectComponent) bool {
+	aID, _ := strconv.Atoi(a.ID)
+	bID, _ := strconv.Atoi(b.ID)
+	return aID < bID
+}
+
+// writeJSON writes body as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+// writeJiraError writes an error response the way Jira does.
+func writeJiraError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, fakeJiraError{ErrorMessages: []string{message}, Errors: map[string]string{}})
+}
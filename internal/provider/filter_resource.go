@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &FilterResource{}
+	_ resource.ResourceWithConfigure      = &FilterResource{}
+	_ resource.ResourceWithImportState    = &FilterResource{}
+	_ resource.ResourceWithValidateConfig = &FilterResource{}
+)
+
+func NewFilterResource() resource.Resource {
+	return &FilterResource{}
+}
+
+// FilterResource defines the resource implementation.
+type FilterResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// filterDetails is a filter as exchanged with the Jira API.
+// The edit permissions are left out, so that Jira keeps the ones granted to the filter.
+type filterDetails struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description"`
+	JQL              string            `json:"jql"`
+	Favourite        bool              `json:"favourite"`
+	SharePermissions []sharePermission `json:"sharePermissions"`
+	SearchURL        string            `json:"searchUrl,omitempty"`
+}
+
+func (r *FilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraFilterResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	JQL              types.String `tfsdk:"jql"`
+	Favourite        types.Bool   `tfsdk:"favourite"`
+	SharePermissions types.Set    `tfsdk:"share_permissions"`
+	SearchURL        types.String `tfsdk:"search_url"`
+}
+
+func (r *FilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filter"
+}
+
+func (r *FilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Filter Resource, i.e. a saved JQL search, e.g. for the boards and dashboards of a team.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira filter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira filter, unique among the filters of its owner.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Jira filter.",
+				Optional:            true,
+			},
+			"jql": schema.StringAttribute{
+				MarkdownDescription: "The JQL query of the Jira filter, e.g. `project = ABC ORDER BY created DESC`. " +
+					"Jira checks the query when the filter is saved, so an invalid query fails the apply with the reason Jira gives.",
+				Required: true,
+			},
+			"favourite": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Jira filter is a favourite of the user the provider authenticates as. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"share_permissions": sharePermissionsAttribute("Who the Jira filter is shared with. The filter is private to its owner when not set. " +
+				"The edit permissions of the filter are left as they are in Jira."),
+			"search_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Jira API search returning the issues matching the filter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraFilterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSharePermissions(ctx, config.SharePermissions)...)
+}
+
+func (r *FilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraFilterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := filterFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newFilter := new(filterDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPost, "rest/api/3/filter", filter, newFilter)
+	if err != nil {
+		resp.Diagnostics.Append(filterSaveError(err, fmt.Sprintf("An unexpected error occurred while creating a new filter named %s... ", state.Name.ValueString()))...)
+		return
+	}
+
+	resp.Diagnostics.Append(setFilterState(ctx, &state, newFilter)...)
+
+	tflog.Trace(ctx, "Created filter", map[string]interface{}{"filter_id": newFilter.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state, priorState JiraFilterResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := filterFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/filter/%s", state.ID.ValueString())
+
+	updatedFilter := new(filterDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPut, apiEndpoint, filter, updatedFilter)
+	if err != nil {
+		resp.Diagnostics.Append(filterSaveError(err, fmt.Sprintf("An unexpected error occurred while updating the filter %s... ", state.ID.ValueString()))...)
+		return
+	}
+
+	// The update endpoint ignores the favourite flag, which has its own endpoint.
+	if !state.Favourite.Equal(priorState.Favourite) {
+		method := http.MethodDelete
+		if state.Favourite.ValueBool() {
+			method = http.MethodPut
+		}
+
+		_, err = doJiraRequest(ctx, r.client, method, apiEndpoint+"/favourite", nil, updatedFilter)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update filter favourite",
+				fmt.Sprintf("An unexpected error occurred while updating whether the filter %s is a favourite... ", state.ID.ValueString())+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(setFilterState(ctx, &state, updatedFilter)...)
+
+	tflog.Trace(ctx, "Updated filter", map[string]interface{}{"filter_id": updatedFilter.ID})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraFilterResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := new(filterDetails)
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/filter/%s", state.ID.ValueString()), nil, filter)
+	if err != nil {
+		// Jira answers with a 400 rather than a 404 for a filter that doesn't exist.
+		if isNotFound(response) || response != nil && response.StatusCode == http.StatusBadRequest {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read filter",
+			fmt.Sprintf("An unexpected error occurred while reading the filter %s... ", state.ID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setFilterState(ctx, &state, filter)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraFilterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/filter/%s", state.ID.ValueString()), nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete filter",
+			fmt.Sprintf("An unexpected error occurred while deleting the filter %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted filter", map[string]interface{}{"filter_id": state.ID.ValueString()})
+}
+
+func (r *FilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// filterSaveError describes the failure to create or update a filter.
+// When Jira rejected the JQL, the error points at the `jql` attribute with the reason Jira gave.
+func filterSaveError(err error, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if reason, ok := invalidJQL(err); ok {
+		diags.AddAttributeError(
+			path.Root("jql"),
+			"Invalid JQL",
+			"Jira rejected the JQL query of the filter: "+reason,
+		)
+		return diags
+	}
+
+	diags.AddError("Failed to save filter", summary+"Jira Cloud client error: "+jiraErrorDetail(err))
+
+	return diags
+}
+
+// invalidJQL returns the reason Jira rejected the JQL query of a request, if it did.
+// Jira reports it as an error of the `jql` field, or as an error message about the JQL query.
+func invalidJQL(err error) (string, bool) {
+	var jiraErr *jira.Error
+	if !errors.As(err, &jiraErr) {
+		return "", false
+	}
+
+	if reason, ok := jiraErr.Errors["jql"]; ok {
+		return reason, true
+	}
+
+	for _, message := range jiraErr.ErrorMessages {
+		if strings.Contains(message, "JQL") {
+			return message, true
+		}
+	}
+
+	return "", false
+}
+
+// filterFromModel builds the Jira representation of the filter out of the model.
+func filterFromModel(ctx context.Context, state *JiraFilterResourceModel) (*filterDetails, diag.Diagnostics) {
+	sharePermissions, diags := sharePermissionsFromModel(ctx, state.SharePermissions)
+
+	return &filterDetails{
+		Name:             state.Name.ValueString(),
+		Description:      state.Description.ValueString(),
+		JQL:              state.JQL.ValueString(),
+		Favourite:        state.Favourite.ValueBool(),
+		SharePermissions: sharePermissions,
+	}, diags
+}
+
+// setFilterState copies the attributes returned by Jira into the model.
+func setFilterState(ctx context.Context, state *JiraFilterResourceModel, filter *filterDetails) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(filter.ID)
+	state.Name = types.StringValue(filter.Name)
+	state.Description = stringValueOrNull(filter.Description)
+	state.JQL = types.StringValue(filter.JQL)
+	state.Favourite = types.BoolValue(filter.Favourite)
+	state.SearchURL = types.StringValue(filter.SearchURL)
+	state.SharePermissions, diags = sharePermissionsValue(ctx, filter.SharePermissions, state.SharePermissions)
+
+	return diags
+}
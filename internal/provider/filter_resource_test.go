@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterResource_CRUD(t *testing.T) {
+	providerData, _, projectKey := testJira(t)
+	h := newResourceHarness(t, NewFilterResource(), providerData)
+
+	name := fmt.Sprintf("tf-test-%d", time.Now().UnixNano())
+
+	// Create
+	state := h.create(JiraFilterResourceModel{
+		ID:               types.StringUnknown(),
+		Name:             types.StringValue(name),
+		Description:      types.StringValue("Created by Terraform"),
+		JQL:              types.StringValue("project = " + projectKey),
+		Favourite:        types.BoolValue(false),
+		SharePermissions: types.SetNull(types.ObjectType{AttrTypes: sharePermissionAttrTypes}),
+		SearchURL:        types.StringUnknown(),
+	})
+
+	var created JiraFilterResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() == "" || created.SearchURL.ValueString() == "" {
+		t.Fatalf("expected the created filter to have an ID and a search URL, got: %+v", created)
+	}
+	if created.JQL.ValueString() != "project = "+projectKey {
+		t.Errorf("unexpected JQL of the created filter: %s", created.JQL)
+	}
+
+	// Read
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created filter to be found")
+	}
+
+	var read JiraFilterResourceModel
+	h.get(state, &read)
+	if !h.raw(&read).Equal(h.raw(&created)) {
+		t.Errorf("expected read to return the created filter\ncreated: %+v\nread:    %+v", created, read)
+	}
+
+	// Update
+	plan := read
+	plan.Name = types.StringValue(name + "-renamed")
+	plan.JQL = types.StringValue("project = " + projectKey + " ORDER BY created DESC")
+	plan.Favourite = types.BoolValue(true)
+	state = h.update(state, plan)
+
+	state, found = h.read(state)
+	if !found {
+		t.Fatal("expected the updated filter to be found")
+	}
+
+	var updated JiraFilterResourceModel
+	h.get(state, &updated)
+	if updated.ID != created.ID {
+		t.Errorf("expected the update to keep the ID %s, got: %s", created.ID, updated.ID)
+	}
+	if updated.Name != plan.Name || updated.JQL != plan.JQL || !updated.Favourite.ValueBool() {
+		t.Errorf("unexpected updated filter: %+v", updated)
+	}
+
+	// Import
+	var imported JiraFilterResourceModel
+	h.get(h.importState(updated.ID.ValueString()), &imported)
+	if !h.raw(&imported).Equal(h.raw(&updated)) {
+		t.Errorf("expected import to return the updated filter\nupdated:  %+v\nimported: %+v", updated, imported)
+	}
+
+	// Delete
+	h.delete(state)
+
+	if _, found := h.read(state); found {
+		t.Error("expected the deleted filter to be removed from the state")
+	}
+}
+
+func TestFilterResource_EmptySharePermissions(t *testing.T) {
+	providerData, _, projectKey := testJira(t)
+	h := newResourceHarness(t, NewFilterResource(), providerData)
+
+	empty := types.SetValueMust(types.ObjectType{AttrTypes: sharePermissionAttrTypes}, nil)
+	state := h.create(JiraFilterResourceModel{
+		ID:               types.StringUnknown(),
+		Name:             types.StringValue(fmt.Sprintf("tf-test-%d", time.Now().UnixNano())),
+		Description:      types.StringNull(),
+		JQL:              types.StringValue("project = " + projectKey),
+		Favourite:        types.BoolValue(false),
+		SharePermissions: empty,
+		SearchURL:        types.StringUnknown(),
+	})
+	t.Cleanup(func() { h.delete(state) })
+
+	var created JiraFilterResourceModel
+	h.get(state, &created)
+	if !created.SharePermissions.Equal(empty) {
+		t.Errorf("expected the created filter to keep the configured empty share permissions, got: %s", created.SharePermissions)
+	}
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created filter to be found")
+	}
+
+	var read JiraFilterResourceModel
+	h.get(state, &read)
+	if !read.SharePermissions.Equal(empty) {
+		t.Errorf("expected read to keep the empty share permissions, got: %s", read.SharePermissions)
+	}
+}
+
+func TestFilterResource_InvalidJQL(t *testing.T) {
+	providerData, _, _ := testJira(t)
+	h := newResourceHarness(t, NewFilterResource(), providerData)
+
+	_, diags := h.tryCreate(JiraFilterResourceModel{
+		ID:               types.StringUnknown(),
+		Name:             types.StringValue(fmt.Sprintf("tf-test-%d", time.Now().UnixNano())),
+		Description:      types.StringNull(),
+		JQL:              types.StringValue("project ="),
+		Favourite:        types.BoolValue(false),
+		SharePermissions: types.SetNull(types.ObjectType{AttrTypes: sharePermissionAttrTypes}),
+		SearchURL:        types.StringUnknown(),
+	})
+
+	if !diags.HasError() {
+		t.Fatal("expected creating a filter with invalid JQL to fail")
+	}
+
+	for _, d := range diags.Errors() {
+		withPath, ok := d.(interface{ Path() path.Path })
+		if !ok || !withPath.Path().Equal(path.Root("jql")) || !strings.Contains(d.Detail(), "JQL") {
+			t.Errorf("expected the error to point at the JQL with the reason Jira gave, got: %s: %s", d.Summary(), d.Detail())
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// groupMembersPageSize is the number of group members requested per page.
+const groupMembersPageSize = 50
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &GroupMembershipResource{}
+	_ resource.ResourceWithConfigure      = &GroupMembershipResource{}
+	_ resource.ResourceWithImportState    = &GroupMembershipResource{}
+	_ resource.ResourceWithValidateConfig = &GroupMembershipResource{}
+)
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+// GroupMembershipResource defines the resource implementation.
+type GroupMembershipResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// groupMemberAddOptions is the payload accepted by the add user to group endpoint.
+type groupMemberAddOptions struct {
+	AccountID string `json:"accountId"`
+}
+
+// groupMembersPage is a single page of the group members endpoint.
+type groupMembersPage struct {
+	IsLast bool        `json:"isLast"`
+	Values []jira.User `json:"values"`
+}
+
+func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraGroupMembershipResourceModel struct {
+	GroupID   types.String `tfsdk:"group_id"`
+	GroupName types.String `tfsdk:"group_name"`
+	AccountID types.String `tfsdk:"account_id"`
+}
+
+func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the membership of a single user in a Jira group.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira group. Exactly one of `group_id` and `group_name` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira group. Exactly one of `group_id` and `group_name` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The Jira account ID of the user to add to the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					isAccountID(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GroupMembershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.GroupID.IsUnknown() || config.GroupName.IsUnknown() {
+		return
+	}
+
+	if config.GroupID.IsNull() == config.GroupName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("group_id"),
+			"Invalid group reference",
+			"Exactly one of `group_id` and `group_name` must be set.",
+		)
+	}
+}
+
+func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraGroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiEndpoint := "rest/api/3/group/user?" + groupQuery(&state).Encode()
+	_, err := doJiraRequest(ctx, r.client, http.MethodPost, apiEndpoint, groupMemberAddOptions{AccountID: state.AccountID.ValueString()}, nil)
+	if err != nil {
+		// Jira refuses to add a user that is already a member, which is exactly the state we want.
+		isMember, memberErr := r.isMember(ctx, &state)
+		if memberErr != nil || !isMember {
+			resp.Diagnostics.AddError(
+				"Failed to add user to group",
+				fmt.Sprintf("An unexpected error occurred while adding the user %s to the group %s... ", state.AccountID.ValueString(), groupReference(&state))+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Added user to group", map[string]interface{}{"account_id": state.AccountID.ValueString(), "group": groupReference(&state)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is never anything to update in place.
+	resp.Diagnostics.AddError("Update Not Supported", "Group memberships can't be updated in place. Please report this issue to the provider developers.")
+}
+
+func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraGroupMembershipResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isMember, err := r.isMember(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read group members",
+			fmt.Sprintf("An unexpected error occurred while reading the members of the group %s... ", groupReference(&state))+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// The user was removed from the group outside of Terraform.
+	if !isMember {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := groupQuery(&state)
+	query.Set("accountId", state.AccountID.ValueString())
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, "rest/api/3/group/user?"+query.Encode(), nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to remove user from group",
+			fmt.Sprintf("An unexpected error occurred while removing the user %s from the group %s... ", state.AccountID.ValueString(), groupReference(&state))+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Removed user from group", map[string]interface{}{"account_id": state.AccountID.ValueString(), "group": groupReference(&state)})
+}
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Account IDs may contain colons themselves, e.g. 557058:f58131cb-b67d-43c7-b30d-6b58d40bd077, group IDs don't.
+	importIDParts := strings.SplitN(req.ID, ":", 2)
+	if len(importIDParts) != 2 || importIDParts[0] == "" || importIDParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Resource ImportState Invalid ID",
+			"Resource import ID must be in the format of `group_id:account_id`.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), importIDParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), importIDParts[1])...)
+}
+
+// isMember pages through the members of the group and reports whether the account is one of them.
+// A group that doesn't exist anymore has no members.
+func (r *GroupMembershipResource) isMember(ctx context.Context, state *JiraGroupMembershipResourceModel) (bool, error) {
+	query := groupQuery(state)
+	query.Set("maxResults", strconv.Itoa(groupMembersPageSize))
+
+	for startAt := 0; ; startAt += groupMembersPageSize {
+		query.Set("startAt", strconv.Itoa(startAt))
+
+		page := new(groupMembersPage)
+		response, err := doJiraRequest(ctx, r.client, http.MethodGet, "rest/api/3/group/member?"+query.Encode(), nil, page)
+		if err != nil {
+			if isNotFound(response) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		for _, member := range page.Values {
+			if member.AccountID == state.AccountID.ValueString() {
+				return true, nil
+			}
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			return false, nil
+		}
+	}
+}
+
+// groupQuery returns the query parameters identifying the group of the membership.
+func groupQuery(state *JiraGroupMembershipResourceModel) url.Values {
+	query := url.Values{}
+	if !state.GroupID.IsNull() {
+		query.Set("groupId", state.GroupID.ValueString())
+	} else {
+		query.Set("groupname", state.GroupName.ValueString())
+	}
+
+	return query
+}
+
+// groupReference returns a human readable reference to the group of the membership for diagnostics.
+func groupReference(state *JiraGroupMembershipResourceModel) string {
+	if !state.GroupID.IsNull() {
+		return state.GroupID.ValueString()
+	}
+
+	return state.GroupName.ValueString()
+}
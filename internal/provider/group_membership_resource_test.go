@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGroupMembershipResource_AddRemove(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewGroupMembershipResource(), providerData)
+
+	// The group has more members than fit on a page, so the membership is only found by paging.
+	var members []string
+	for i := 0; i < groupMembersPageSize+10; i++ {
+		members = append(members, fake.addUser(fmt.Sprintf("member-%d", i), fmt.Sprintf("member-%d@example.com", i), "Member").AccountID)
+	}
+	group := fake.addGroup("developers", members...)
+	user := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+
+	// Add
+	state := h.create(JiraGroupMembershipResourceModel{
+		GroupID:   types.StringNull(),
+		GroupName: types.StringValue(group.Name),
+		AccountID: types.StringValue(user.AccountID),
+	})
+	if last := group.Members[len(group.Members)-1]; last != user.AccountID {
+		t.Fatalf("expected %s to be added to the group, got the last member: %s", user.AccountID, last)
+	}
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the added membership to be found")
+	}
+
+	// Import, by group ID
+	var imported JiraGroupMembershipResourceModel
+	h.get(h.importState(group.ID+":"+user.AccountID), &imported)
+	if imported.GroupID.ValueString() != group.ID || imported.AccountID.ValueString() != user.AccountID {
+		t.Errorf("expected the imported membership of %s in %s, got: %+v", user.AccountID, group.ID, imported)
+	}
+
+	// Remove
+	h.delete(state)
+	for _, member := range group.Members {
+		if member == user.AccountID {
+			t.Fatalf("expected %s to be removed from the group", user.AccountID)
+		}
+	}
+
+	if _, found := h.read(state); found {
+		t.Error("expected the removed membership to be removed from the state")
+	}
+
+	// Removing a membership that is already gone succeeds.
+	h.delete(state)
+}
+
+func TestGroupMembershipResource_AlreadyMember(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewGroupMembershipResource(), providerData)
+
+	user := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+	group := fake.addGroup("developers", user.AccountID)
+
+	// Jira refuses to add an existing member, which is the membership Terraform wants anyway.
+	h.create(JiraGroupMembershipResourceModel{
+		GroupID:   types.StringValue(group.ID),
+		GroupName: types.StringNull(),
+		AccountID: types.StringValue(user.AccountID),
+	})
+
+	// Adding a user to a group that doesn't exist fails.
+	_, diags := h.tryCreate(JiraGroupMembershipResourceModel{
+		GroupID:   types.StringNull(),
+		GroupName: types.StringValue("missing"),
+		AccountID: types.StringValue(user.AccountID),
+	})
+	if !diags.HasError() {
+		t.Error("expected adding a user to a missing group to fail")
+	}
+}
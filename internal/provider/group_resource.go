@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &GroupResource{}
+	_ resource.ResourceWithConfigure   = &GroupResource{}
+	_ resource.ResourceWithImportState = &GroupResource{}
+)
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+// GroupResource defines the resource implementation.
+type GroupResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// groupCreateOptions is the payload accepted by the group create endpoint.
+type groupCreateOptions struct {
+	Name string `json:"name"`
+}
+
+// groupDetails is the representation of a group returned by the group endpoints.
+// Unlike the go-jira Group type, it contains the group ID.
+type groupDetails struct {
+	Name    string `json:"name"`
+	GroupID string `json:"groupId"`
+	Self    string `json:"self"`
+}
+
+func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraGroupResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	GroupID     types.String `tfsdk:"group_id"`
+	Self        types.String `tfsdk:"self"`
+	SwapGroupID types.String `tfsdk:"swap_group_id"`
+}
+
+func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Group Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira group. Groups can't be renamed, so changing it replaces the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira group. Prefer it over the name when referencing the group, " +
+					"as Jira is moving away from group names in its APIs.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"self": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Jira group in the Jira REST API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"swap_group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the group to transfer the restrictions of this group to when it is deleted, " +
+					"e.g. comment and worklog visibility. If not set, the restrictions are removed.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newGroup := new(groupDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPost, "rest/api/3/group", groupCreateOptions{Name: state.Name.ValueString()}, newGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create group",
+			fmt.Sprintf("An unexpected error occurred while creating a new group named %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(newGroup.Name)
+	state.GroupID = types.StringValue(newGroup.GroupID)
+	state.Self = types.StringValue(newGroup.Self)
+
+	tflog.Trace(ctx, "Created group", map[string]interface{}{"group_id": newGroup.GroupID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state JiraGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only swap_group_id can change in place, and it is only used on deletion.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraGroupResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := new(groupDetails)
+	apiEndpoint := "rest/api/3/group?groupId=" + url.QueryEscape(state.GroupID.ValueString())
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, apiEndpoint, nil, group)
+	if err != nil {
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read group",
+			fmt.Sprintf("An unexpected error occurred while reading the group %s... ", state.GroupID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(group.Name)
+	state.GroupID = types.StringValue(group.GroupID)
+	state.Self = types.StringValue(group.Self)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("groupId", state.GroupID.ValueString())
+	if !state.SwapGroupID.IsNull() {
+		query.Set("swapGroupId", state.SwapGroupID.ValueString())
+	}
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, "rest/api/3/group?"+query.Encode(), nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete group",
+			fmt.Sprintf("An unexpected error occurred while deleting the group %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted group", map[string]interface{}{"group_id": state.GroupID.ValueString()})
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_id"), req, resp)
+}
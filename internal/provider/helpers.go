@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringValueOrNull converts an empty string returned by Jira into a null value,
+// so that optional attributes left out of the configuration don't show up as a diff.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// isEmptySet reports whether the value is a known set without elements, e.g. a configured `[]`.
+// Jira doesn't distinguish it from a missing value, so it is kept as is rather than read back as null.
+func isEmptySet(value types.Set) bool {
+	return !value.IsNull() && !value.IsUnknown() && len(value.Elements()) == 0
+}
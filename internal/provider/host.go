@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// normalizeHost turns the configured host into the base URL of the Jira API client.
+// A bare hostname, with or without a port, gets the https scheme, and trailing slashes are removed.
+// Plain http is only accepted when explicitly allowed, since it sends the credentials in clear text.
+func normalizeHost(host string, allowInsecure bool) (string, error) {
+	host = strings.TrimSpace(host)
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	hostURL, err := url.Parse(host)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid URL: %w", host, err)
+	}
+
+	switch hostURL.Scheme {
+	case "https":
+	case "http":
+		if !allowInsecure {
+			return "", fmt.Errorf("%q uses plain http, which would send the credentials unencrypted; use https or set `allow_insecure`", host)
+		}
+	default:
+		return "", fmt.Errorf("%q uses the unsupported scheme %q, use https", host, hostURL.Scheme)
+	}
+
+	if hostURL.Host == "" {
+		return "", fmt.Errorf("%q has no hostname", host)
+	}
+
+	hostURL.Path = strings.TrimRight(hostURL.Path, "/")
+
+	return hostURL.String(), nil
+}
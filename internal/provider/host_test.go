@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeHost(t *testing.T) {
+	tests := []struct {
+		name          string
+		host          string
+		allowInsecure bool
+		want          string
+		wantErr       bool
+	}{
+		{name: "bare hostname", host: "example.atlassian.net", want: "https://example.atlassian.net"},
+		{name: "bare hostname with port", host: "jira.example.com:8443", want: "https://jira.example.com:8443"},
+		{name: "https URL", host: "https://example.atlassian.net", want: "https://example.atlassian.net"},
+		{name: "trailing slash", host: "https://example.atlassian.net/", want: "https://example.atlassian.net"},
+		{name: "trailing slashes", host: "example.atlassian.net///", want: "https://example.atlassian.net"},
+		{name: "path with trailing slash", host: "https://example.com/jira/", want: "https://example.com/jira"},
+		{name: "port with trailing slash", host: "https://jira.example.com:8443/", want: "https://jira.example.com:8443"},
+		{name: "surrounding spaces", host: "  example.atlassian.net  ", want: "https://example.atlassian.net"},
+		{name: "plain http allowed", host: "http://localhost:8080/", allowInsecure: true, want: "http://localhost:8080"},
+		{name: "plain http refused", host: "http://localhost:8080", wantErr: true},
+		{name: "unsupported scheme", host: "ftp://example.atlassian.net", wantErr: true},
+		{name: "no hostname", host: "https://", wantErr: true},
+		{name: "invalid port", host: "example.atlassian.net:port", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := normalizeHost(test.host, test.allowInsecure)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("expected normalizeHost(%q) to fail, got: %q", test.host, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("normalizeHost(%q): %v", test.host, err)
+			}
+			if got != test.want {
+				t.Errorf("normalizeHost(%q): expected %q, got: %q", test.host, test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// newHTTPTransport returns the transport the API requests go through, before authentication and retries.
+// Without a proxy URL, the proxy is taken from the HTTPS_PROXY and NO_PROXY environment variables.
+// The CA certificates of the PEM bundle, if any, are trusted on top of the system ones.
+func newHTTPTransport(proxyURL, caCertFile string, insecureSkipVerify bool) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxyURL != "" {
+		proxy, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("the proxy URL %q is not a valid URL: %w", proxyURL, err)
+		}
+
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("the proxy URL %q must include a scheme and a hostname, e.g. `http://proxy.example.com:3128`", proxyURL)
+		}
+
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec // Explicitly requested by the provider configuration.
+	}
+
+	if caCertFile != "" {
+		rootCAs, err := loadCACertPool(caCertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// loadCACertPool returns the system certificate pool extended with the certificates of the PEM bundle.
+func loadCACertPool(caCertFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA certificate file: %w", err)
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if !rootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("the CA certificate file %q contains no PEM encoded certificate", caCertFile)
+	}
+
+	return rootCAs, nil
+}
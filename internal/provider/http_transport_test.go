@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeCACertFile writes the certificate of the TLS test server to a PEM file, and returns its path.
+func writeCACertFile(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}
+	if err := os.WriteFile(caCertFile, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatalf("writing the CA certificate file: %v", err)
+	}
+
+	return caCertFile
+}
+
+func TestNewHTTPTransport_CustomCA(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	// The handshake refused by the client is expected.
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	// The certificate of the test server isn't trusted by the system.
+	transport, err := newHTTPTransport("", "", false)
+	if err != nil {
+		t.Fatalf("newHTTPTransport: %v", err)
+	}
+	if resp, err := (&http.Client{Transport: transport}).Get(server.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected the certificate of the test server not to be trusted without the CA certificate file")
+	}
+
+	transport, err = newHTTPTransport("", writeCACertFile(t, server), false)
+	if err != nil {
+		t.Fatalf("newHTTPTransport: %v", err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the certificate of the test server to be trusted with the CA certificate file: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("expected status %d, got: %d", http.StatusNoContent, resp.StatusCode)
+	}
+}
+
+func TestNewHTTPTransport_InvalidSettings(t *testing.T) {
+	notPEM := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("writing the CA certificate file: %v", err)
+	}
+
+	tests := map[string]struct {
+		proxyURL   string
+		caCertFile string
+	}{
+		"missing CA certificate file": {caCertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"CA certificate file not PEM": {caCertFile: notPEM},
+		"proxy URL without scheme":    {proxyURL: "proxy.example.com:3128"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPTransport(test.proxyURL, test.caCertFile, false); err == nil {
+				t.Error("expected newHTTPTransport to fail")
+			}
+		})
+	}
+}
+
+func TestProvider_ConfigureBadCACertFile(t *testing.T) {
+	caCertFile := filepath.Join(t.TempDir(), "missing.pem")
+
+	_, diags := tryConfigureTestProvider(t, JiraCloudProviderModel{
+		Host:          types.StringValue("example.atlassian.net"),
+		UserEmail:     types.StringValue("terraform@example.com"),
+		ApiToken:      types.StringValue("token"),
+		RetryOnStatus: types.ListNull(types.Int64Type),
+		CACertFile:    types.StringValue(caCertFile),
+	})
+
+	if !diags.HasError() {
+		t.Fatal("expected configuring the provider with a missing CA certificate file to fail")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "ca_cert_file") || !strings.Contains(detail, caCertFile) {
+		t.Errorf("expected the diagnostic to point at the CA certificate file, got: %s: %s", diags[0].Summary(), detail)
+	}
+}
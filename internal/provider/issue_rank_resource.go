@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	issueRankBefore = "before"
+	issueRankAfter  = "after"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &IssueRankResource{}
+	_ resource.ResourceWithConfigure      = &IssueRankResource{}
+	_ resource.ResourceWithValidateConfig = &IssueRankResource{}
+)
+
+func NewIssueRankResource() resource.Resource {
+	return &IssueRankResource{}
+}
+
+// IssueRankResource defines the resource implementation.
+type IssueRankResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// issueRankOptions is the payload accepted by the Agile issue rank endpoint.
+type issueRankOptions struct {
+	Issues          []string `json:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue  string   `json:"rankAfterIssue,omitempty"`
+}
+
+// issueRankResult is the response of the Agile issue rank endpoint when some issues could not be ranked.
+type issueRankResult struct {
+	Entries []struct {
+		IssueKey string   `json:"issueKey"`
+		Status   int      `json:"status"`
+		Errors   []string `json:"errors"`
+	} `json:"entries"`
+}
+
+func (r *IssueRankResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraIssueRankResourceModel struct {
+	IssueKey          types.String `tfsdk:"issue_key"`
+	ReferenceIssueKey types.String `tfsdk:"reference_issue_key"`
+	Position          types.String `tfsdk:"position"`
+}
+
+func (r *IssueRankResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_rank"
+}
+
+func (r *IssueRankResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Ranks a Jira issue before or after another issue on the boards and backlogs. " +
+			"The rank is only applied when the resource is created or changed: ranks are relative and other users " +
+			"reorder issues all the time, so moving the issues around outside of Terraform is not detected as drift. " +
+			"Destroying the resource leaves the issues where they are.",
+
+		Attributes: map[string]schema.Attribute{
+			"issue_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the issue to rank, e.g. `ABC-42`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_issue_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the issue to rank `issue_key` relative to.",
+				Required:            true,
+			},
+			"position": schema.StringAttribute{
+				MarkdownDescription: "Whether to rank `issue_key` `before` or `after` the reference issue. Defaults to `before`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(issueRankBefore),
+				Validators: []validator.String{
+					stringvalidator.OneOf(issueRankBefore, issueRankAfter),
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueRankResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraIssueRankResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.IssueKey.IsUnknown() && !config.ReferenceIssueKey.IsUnknown() && config.IssueKey.Equal(config.ReferenceIssueKey) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reference_issue_key"),
+			"Invalid reference issue",
+			"An issue can't be ranked relative to itself.",
+		)
+	}
+}
+
+func (r *IssueRankResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueRankResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rankIssue(ctx, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to rank issue",
+			fmt.Sprintf("An unexpected error occurred while ranking the issue %s %s %s... ", state.IssueKey.ValueString(), state.Position.ValueString(), state.ReferenceIssueKey.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Ranked issue", map[string]interface{}{"issue_key": state.IssueKey.ValueString(), "position": state.Position.ValueString(), "reference_issue_key": state.ReferenceIssueKey.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueRankResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueRankResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rankIssue(ctx, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to rank issue",
+			fmt.Sprintf("An unexpected error occurred while ranking the issue %s %s %s... ", state.IssueKey.ValueString(), state.Position.ValueString(), state.ReferenceIssueKey.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Re-ranked issue", map[string]interface{}{"issue_key": state.IssueKey.ValueString(), "position": state.Position.ValueString(), "reference_issue_key": state.ReferenceIssueKey.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueRankResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueRankResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Jira doesn't expose the relative order of two issues, so the best we can do is notice that the ranked issue is gone.
+	issue := new(jira.Issue)
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/issue/%s?fields=summary", state.IssueKey.ValueString()), nil, issue)
+	if err != nil {
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read issue",
+			fmt.Sprintf("An unexpected error occurred while reading the issue %s... ", state.IssueKey.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueRankResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A rank can't be undone, the issue simply stays where it is.
+}
+
+// rankIssue moves the issue before or after the reference issue.
+// The rank endpoint answers with 207 Multi-Status when it couldn't rank the issue.
+func (r *IssueRankResource) rankIssue(ctx context.Context, state *JiraIssueRankResourceModel) error {
+	options := issueRankOptions{
+		Issues: []string{state.IssueKey.ValueString()},
+	}
+	if state.Position.ValueString() == issueRankAfter {
+		options.RankAfterIssue = state.ReferenceIssueKey.ValueString()
+	} else {
+		options.RankBeforeIssue = state.ReferenceIssueKey.ValueString()
+	}
+
+	result := new(issueRankResult)
+	response, err := doJiraRequest(ctx, r.client, http.MethodPut, "rest/agile/1.0/issue/rank", options, result)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode == http.StatusMultiStatus {
+		var messages []string
+		for _, entry := range result.Entries {
+			messages = append(messages, entry.Errors...)
+		}
+
+		return fmt.Errorf("the issue could not be ranked: %s", strings.Join(messages, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIssueRankResource(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueRankResource(), providerData)
+
+	first := fake.addIssue(projectKey, "Story", "Backend", "")
+	second := fake.addIssue(projectKey, "Story", "Frontend", "")
+
+	var ranked []issueRankOptions
+	fake.handle(http.MethodPut, "/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		var options issueRankOptions
+		if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+			writeJiraError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		ranked = append(ranked, options)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// Create
+	state := h.create(JiraIssueRankResourceModel{
+		IssueKey:          types.StringValue(second.Key),
+		ReferenceIssueKey: types.StringValue(first.Key),
+		Position:          types.StringValue(issueRankBefore),
+	})
+	if len(ranked) != 1 || ranked[0].Issues[0] != second.Key || ranked[0].RankBeforeIssue != first.Key || ranked[0].RankAfterIssue != "" {
+		t.Fatalf("expected %s to be ranked before %s, got: %+v", second.Key, first.Key, ranked)
+	}
+
+	// Update
+	plan := JiraIssueRankResourceModel{
+		IssueKey:          types.StringValue(second.Key),
+		ReferenceIssueKey: types.StringValue(first.Key),
+		Position:          types.StringValue(issueRankAfter),
+	}
+	state = h.update(state, plan)
+	if len(ranked) != 2 || ranked[1].RankAfterIssue != first.Key || ranked[1].RankBeforeIssue != "" {
+		t.Fatalf("expected %s to be ranked after %s, got: %+v", second.Key, first.Key, ranked)
+	}
+
+	// Read
+	if _, found := h.read(state); !found {
+		t.Error("expected the rank of an existing issue to be found")
+	}
+
+	if _, err := providerData.Client.Issue.Delete(context.Background(), second.Key); err != nil {
+		t.Fatalf("deleting the ranked issue: %v", err)
+	}
+
+	if _, found := h.read(state); found {
+		t.Error("expected the rank of a deleted issue to be removed from the state")
+	}
+}
+
+func TestIssueRankResource_RankFailure(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueRankResource(), providerData)
+
+	first := fake.addIssue(projectKey, "Story", "Backend", "")
+	second := fake.addIssue(projectKey, "Story", "Frontend", "")
+
+	// Jira answers with 207 Multi-Status, rather than an error, when it can't rank the issue.
+	fake.respond(http.MethodPut, "/rest/agile/1.0/issue/rank", http.StatusMultiStatus, map[string]interface{}{
+		"entries": []map[string]interface{}{
+			{"issueKey": second.Key, "status": http.StatusBadRequest, "errors": []string{"The rank field is not configured."}},
+		},
+	})
+
+	_, diags := h.tryCreate(JiraIssueRankResourceModel{
+		IssueKey:          types.StringValue(second.Key),
+		ReferenceIssueKey: types.StringValue(first.Key),
+		Position:          types.StringValue(issueRankBefore),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected a rank Jira couldn't apply to fail")
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "The rank field is not configured.") {
+		t.Errorf("expected the error to hold the message of Jira, got: %s", detail)
+	}
+}
+
+func TestIssueRankResource_Validate(t *testing.T) {
+	providerData, _, _ := testJira(t)
+	h := newResourceHarness(t, NewIssueRankResource(), providerData)
+
+	tests := []struct {
+		name         string
+		referenceKey string
+		position     types.String
+		wantErr      bool
+	}{
+		{"before", "ABC-2", types.StringValue(issueRankBefore), false},
+		{"after", "ABC-2", types.StringValue(issueRankAfter), false},
+		{"default position", "ABC-2", types.StringNull(), false},
+		{"unknown position", "ABC-2", types.StringValue("above"), true},
+		{"capitalized position", "ABC-2", types.StringValue("Before"), true},
+		{"relative to itself", "ABC-1", types.StringValue(issueRankBefore), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := h.validate(JiraIssueRankResourceModel{
+				IssueKey:          types.StringValue("ABC-1"),
+				ReferenceIssueKey: types.StringValue(test.referenceKey),
+				Position:          test.position,
+			})
+			if diags.HasError() != test.wantErr {
+				t.Errorf("expected an error: %v, got: %v", test.wantErr, diags)
+			}
+		})
+	}
+}
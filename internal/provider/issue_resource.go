@@ -0,0 +1,736 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-jiracloud/internal/adf"
+)
+
+const (
+	// descriptionFormatPlain sends descriptions as plain strings through the version 2 of the Jira API.
+	descriptionFormatPlain = "plain"
+	// descriptionFormatADF converts descriptions to the Atlassian Document Format and sends them through the version 3.
+	descriptionFormatADF = "adf"
+
+	// issueEditMaxAttempts bounds how many times an edit is attempted when Jira reports a concurrent change of the issue.
+	issueEditMaxAttempts = 3
+
+	// issueEditConflictDelay is the delay before retrying an edit that conflicted, multiplied by the number of attempts so far.
+	issueEditConflictDelay = 500 * time.Millisecond
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IssueResource{}
+	_ resource.ResourceWithConfigure   = &IssueResource{}
+	_ resource.ResourceWithImportState = &IssueResource{}
+	_ resource.ResourceWithModifyPlan  = &IssueResource{}
+)
+
+func NewIssueResource() resource.Resource {
+	return &IssueResource{}
+}
+
+// issueDescriptionADF holds the description of an issue as returned by the version 3 of the Jira API.
+type issueDescriptionADF struct {
+	Fields struct {
+		Description *adf.Node `json:"description"`
+	} `json:"fields"`
+}
+
+// issueHierarchyLevel holds the issue type of an issue, which the go-jira IssueType type lacks the hierarchy level of.
+type issueHierarchyLevel struct {
+	Fields struct {
+		IssueType issueTypeDetails `json:"issuetype"`
+	} `json:"fields"`
+}
+
+// IssueResource defines the resource implementation.
+type IssueResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+	priorities     *priorityCache
+}
+
+func (r *IssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+	r.priorities = providerData.Priorities
+}
+
+type JiraIssueResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Key               types.String `tfsdk:"key"`
+	Project           types.String `tfsdk:"project"`
+	IssueType         types.String `tfsdk:"issue_type"`
+	Summary           types.String `tfsdk:"summary"`
+	Description       types.String `tfsdk:"description"`
+	DescriptionFormat types.String `tfsdk:"description_format"`
+	Labels            types.Set    `tfsdk:"labels"`
+	AssigneeAccountID types.String `tfsdk:"assignee_account_id"`
+	Priority          types.String `tfsdk:"priority"`
+	PriorityID        types.String `tfsdk:"priority_id"`
+	Components        types.Set    `tfsdk:"components"`
+	ParentKey         types.String `tfsdk:"parent_key"`
+}
+
+func (r *IssueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue"
+}
+
+func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Issue Resource, e.g. for tracking issues like release checklists.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira issue.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira issue, e.g. `ABC-42`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira project that the issue belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_type": schema.StringAttribute{
+				MarkdownDescription: "The name of the issue type, e.g. `Task`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"summary": schema.StringAttribute{
+				MarkdownDescription: "The summary of the Jira issue.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Jira issue as plain text. See `description_format` for how it is sent to Jira.",
+				Optional:            true,
+			},
+			"description_format": schema.StringAttribute{
+				MarkdownDescription: "How the description is sent to Jira. With `plain`, the default, it is sent as is through " +
+					"the version 2 of the Jira API, which interprets it as wiki markup. With `adf`, it is converted to the " +
+					"Atlassian Document Format: paragraphs separated by blank lines, bullet list items starting with `- ` " +
+					"and `inline code` are supported. The configured description is kept as long as Jira holds an equivalent " +
+					"document, and the document is flattened back to text otherwise.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(descriptionFormatPlain),
+				Validators: []validator.String{
+					stringvalidator.OneOf(descriptionFormatPlain, descriptionFormatADF),
+				},
+			},
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "The labels of the Jira issue.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"assignee_account_id": schema.StringAttribute{
+				MarkdownDescription: "The assignee of the Jira issue represented by their Jira account ID. " +
+					"Removing the attribute unassigns the issue.",
+				Optional: true,
+				Validators: []validator.String{
+					isAccountID(),
+				},
+			},
+			"priority": schema.StringAttribute{
+				MarkdownDescription: "The name of the priority of the Jira issue, e.g. `High`, ignoring case. " +
+					"Defaults to the default priority of the project.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the priority of the Jira issue.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"components": schema.SetAttribute{
+				MarkdownDescription: "The names of the components of the Jira issue.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"parent_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the parent of the Jira issue, e.g. the epic of a story or the issue of a subtask. " +
+					"Changing the parent moves the issue in place, unless the new parent sits at another level of the issue " +
+					"hierarchy than the current one, e.g. when removing the parent of a subtask, which forces a new issue.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := issueFieldsFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.resolvePriority(ctx, &state, fields)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields.Project = jira.Project{Key: state.Project.ValueString()}
+	fields.Type = jira.IssueType{Name: state.IssueType.ValueString()}
+	if !state.ParentKey.IsNull() {
+		fields.Parent = &jira.Parent{Key: state.ParentKey.ValueString()}
+	}
+	if state.DescriptionFormat.ValueString() == descriptionFormatADF {
+		fields.Description = ""
+	}
+
+	newIssue, _, err := r.client.Issue.Create(ctx, &jira.Issue{Fields: fields})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create issue",
+			fmt.Sprintf("An unexpected error occurred while creating a new issue in the %s project... ", state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// Record the new issue right away, so that it is tracked, and replaced as tainted, if completing its creation fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), newIssue.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), newIssue.Key)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description_format"), state.DescriptionFormat)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The version 2 of the API used to create the issue doesn't understand ADF, so the description is set separately.
+	if state.DescriptionFormat.ValueString() == descriptionFormatADF && !state.Description.IsNull() {
+		editedFields := map[string]interface{}{"description": adf.FromText(state.Description.ValueString())}
+		_, err = doJiraRequest(ctx, r.client, http.MethodPut, "rest/api/3/issue/"+newIssue.Key, map[string]interface{}{"fields": editedFields}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to set issue description",
+				fmt.Sprintf("An unexpected error occurred while setting the description of the newly created issue %s... ", newIssue.Key)+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+	}
+
+	// The create endpoint only answers with the ID and the key of the new issue.
+	issue, _, err := r.getIssue(ctx, newIssue.Key, state.DescriptionFormat.ValueString(), state.Description)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read issue",
+			fmt.Sprintf("An unexpected error occurred while reading the newly created issue %s... ", newIssue.Key)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setIssueState(ctx, &state, issue)...)
+
+	tflog.Trace(ctx, "Created issue", map[string]interface{}{"issue_key": issue.Key, "project": state.Project.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on creation and destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state JiraIssueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The priority ID kept from the state is only right as long as the priority doesn't change.
+	if !plan.Priority.IsUnknown() && !strings.EqualFold(plan.Priority.ValueString(), state.Priority.ValueString()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("priority_id"), types.StringUnknown())...)
+	}
+
+	if plan.ParentKey.IsUnknown() || plan.ParentKey.Equal(state.ParentKey) {
+		return
+	}
+
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	inPlace, err := r.canMoveInPlace(ctx, state.ParentKey.ValueString(), plan.ParentKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent_key"),
+			"Failed to read parent issue",
+			fmt.Sprintf("An unexpected error occurred while reading the parent issues of %s... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	if !inPlace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("parent_key"))
+	}
+}
+
+func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state, priorState JiraIssueResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := issueFieldsFromModel(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.resolvePriority(ctx, &state, fields)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unlike the create endpoint, the edit endpoint needs explicit empty values to clear fields.
+	var assignee interface{}
+	if fields.Assignee != nil {
+		assignee = fields.Assignee
+	}
+
+	var description interface{}
+	if fields.Description != "" {
+		description = fields.Description
+	}
+
+	apiVersion := "2"
+	if state.DescriptionFormat.ValueString() == descriptionFormatADF {
+		apiVersion = "3"
+		if fields.Description != "" {
+			description = adf.FromText(fields.Description)
+		}
+	}
+
+	components := make([]jira.Component, 0, len(fields.Components))
+	for _, component := range fields.Components {
+		components = append(components, *component)
+	}
+
+	labels := fields.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	editedFields := map[string]interface{}{
+		"summary":     fields.Summary,
+		"description": description,
+		"labels":      labels,
+		"assignee":    assignee,
+		"components":  components,
+	}
+	if fields.Priority != nil {
+		editedFields["priority"] = fields.Priority
+	}
+	// The parent is only sent when it changes, as Jira refuses to edit it on some issue types even to the same value.
+	if !state.ParentKey.Equal(priorState.ParentKey) {
+		var parent interface{}
+		if !state.ParentKey.IsNull() {
+			parent = jira.Parent{Key: state.ParentKey.ValueString()}
+		}
+		editedFields["parent"] = parent
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/%s/issue/%s", apiVersion, state.Key.ValueString())
+	err := r.editIssue(ctx, apiEndpoint, state.Key.ValueString(), editedFields)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update issue",
+			fmt.Sprintf("An unexpected error occurred while updating the issue %s... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	issue, _, err := r.getIssue(ctx, state.Key.ValueString(), state.DescriptionFormat.ValueString(), state.Description)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read issue",
+			fmt.Sprintf("An unexpected error occurred while reading the issue %s... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setIssueState(ctx, &state, issue)...)
+
+	tflog.Trace(ctx, "Updated issue", map[string]interface{}{"issue_key": issue.Key, "project": state.Project.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The issue is looked up by ID, which unlike the key survives moving the issue to another project.
+	// The ID is missing right after an import by key.
+	issueIDOrKey := state.ID.ValueString()
+	if issueIDOrKey == "" {
+		issueIDOrKey = state.Key.ValueString()
+	}
+
+	// Imported issues have no value for the provider-only settings yet.
+	if state.DescriptionFormat.IsNull() {
+		state.DescriptionFormat = types.StringValue(descriptionFormatPlain)
+	}
+
+	issue, response, err := r.getIssue(ctx, issueIDOrKey, state.DescriptionFormat.ValueString(), state.Description)
+	if err != nil {
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read issue",
+			fmt.Sprintf("An unexpected error occurred while reading the issue %s... ", issueIDOrKey)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setIssueState(ctx, &state, issue)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Jira refuses to delete an issue with subtasks unless asked to delete them as well.
+	apiEndpoint := fmt.Sprintf("rest/api/3/issue/%s?deleteSubtasks=true", state.ID.ValueString())
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, apiEndpoint, nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete issue",
+			fmt.Sprintf("An unexpected error occurred while deleting the issue %s... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted issue", map[string]interface{}{"issue_key": state.Key.ValueString(), "project": state.Project.ValueString()})
+}
+
+func (r *IssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+// editIssue sends the edited fields of an issue to the given edit endpoint.
+// When Jira reports that the issue was changed concurrently, the issue is read again and the edit is retried,
+// up to issueEditMaxAttempts attempts in total.
+func (r *IssueResource) editIssue(ctx context.Context, apiEndpoint, issueKey string, editedFields map[string]interface{}) error {
+	for attempt := 1; ; attempt++ {
+		response, err := doJiraRequest(ctx, r.client, http.MethodPut, apiEndpoint, map[string]interface{}{"fields": editedFields}, nil)
+		if err == nil || !isConflict(response) {
+			return err
+		}
+
+		if attempt >= issueEditMaxAttempts {
+			return fmt.Errorf("the issue kept changing concurrently, giving up after %d attempts: %w", attempt, err)
+		}
+
+		tflog.Debug(ctx, "Issue changed concurrently, retrying the edit", map[string]interface{}{"issue_key": issueKey, "attempt": attempt + 1, "max_attempts": issueEditMaxAttempts})
+
+		timer := time.NewTimer(time.Duration(attempt) * issueEditConflictDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		// Jira doesn't version edits, so the same fields are sent again and overwrite the concurrent change of them.
+		// Reading the issue first surfaces its deletion rather than retrying in vain.
+		_, _, err = r.client.Issue.Get(ctx, issueKey, &jira.GetQueryOptions{Fields: "updated"})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// canMoveInPlace reports whether an issue can be moved from one parent to another by editing it, either parent being
+// empty for none. Jira only moves issues within their level of the issue hierarchy, so both parents must be at the same
+// level. Having no parent fits any level but the one of standard issues, as subtasks can't go without a parent.
+func (r *IssueResource) canMoveInPlace(ctx context.Context, oldParentKey, newParentKey string) (bool, error) {
+	levels := make([]int64, 0, 2)
+	for _, parentKey := range []string{oldParentKey, newParentKey} {
+		if parentKey == "" {
+			continue
+		}
+
+		level, err := r.hierarchyLevel(ctx, parentKey)
+		if err != nil {
+			return false, err
+		}
+
+		levels = append(levels, level)
+	}
+
+	switch len(levels) {
+	case 0:
+		// Neither side has a parent, so there is nothing to move.
+		return true, nil
+	case 2:
+		return levels[0] == levels[1], nil
+	default:
+		return levels[0] != 0, nil
+	}
+}
+
+// hierarchyLevel returns the level of an issue in the issue hierarchy, e.g. `0` for standard issues.
+func (r *IssueResource) hierarchyLevel(ctx context.Context, issueKey string) (int64, error) {
+	issue := new(issueHierarchyLevel)
+	_, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/issue/%s?fields=issuetype", issueKey), nil, issue)
+	if err != nil {
+		return 0, err
+	}
+
+	return issue.Fields.IssueType.HierarchyLevel, nil
+}
+
+// getIssue fetches an issue by its ID or key.
+// With the ADF description format, the description is read from the version 3 of the API and flattened to text,
+// unless it is equivalent to the known description, i.e. the planned or prior one, which is kept instead.
+// Flattening doesn't restore the exact text a document was built from, which would otherwise show up as a change.
+func (r *IssueResource) getIssue(ctx context.Context, issueIDOrKey, descriptionFormat string, knownDescription types.String) (*jira.Issue, *jira.Response, error) {
+	issue, response, err := r.client.Issue.Get(ctx, issueIDOrKey, nil)
+	if err != nil || descriptionFormat != descriptionFormatADF || issue.Fields == nil {
+		return issue, response, err
+	}
+
+	description := new(issueDescriptionADF)
+	response, err = doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/issue/%s?fields=description", issue.ID), nil, description)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if !knownDescription.IsNull() && !knownDescription.IsUnknown() && adf.Equivalent(knownDescription.ValueString(), description.Fields.Description) {
+		issue.Fields.Description = knownDescription.ValueString()
+	} else {
+		issue.Fields.Description = adf.ToText(description.Fields.Description)
+	}
+
+	return issue, response, nil
+}
+
+// resolvePriority sets the priority of the fields to the ID of the priority named in the model.
+func (r *IssueResource) resolvePriority(ctx context.Context, state *JiraIssueResourceModel, fields *jira.IssueFields) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.Priority.IsNull() || state.Priority.IsUnknown() {
+		return diags
+	}
+
+	priorityID, err := r.priorities.idByName(ctx, r.client, state.Priority.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("priority"),
+			"Failed to resolve priority",
+			fmt.Sprintf("An unexpected error occurred while resolving the priority %s... ", state.Priority.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return diags
+	}
+
+	fields.Priority = &jira.Priority{ID: priorityID}
+
+	return diags
+}
+
+// issueFieldsFromModel builds the go-jira representation of the mutable issue fields out of the model.
+func issueFieldsFromModel(ctx context.Context, state *JiraIssueResourceModel) (*jira.IssueFields, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fields := &jira.IssueFields{
+		Summary:     state.Summary.ValueString(),
+		Description: state.Description.ValueString(),
+	}
+
+	if !state.Labels.IsNull() && !state.Labels.IsUnknown() {
+		diags.Append(state.Labels.ElementsAs(ctx, &fields.Labels, false)...)
+	}
+
+	if !state.Components.IsNull() && !state.Components.IsUnknown() {
+		var componentNames []string
+		diags.Append(state.Components.ElementsAs(ctx, &componentNames, false)...)
+
+		for _, componentName := range componentNames {
+			fields.Components = append(fields.Components, &jira.Component{Name: componentName})
+		}
+	}
+
+	if !state.AssigneeAccountID.IsNull() {
+		fields.Assignee = &jira.User{AccountID: state.AssigneeAccountID.ValueString()}
+	}
+
+	return fields, diags
+}
+
+// setIssueState copies the attributes returned by Jira into the model.
+// Empty labels and components are stored as null, so that leaving them out of the configuration doesn't show up as a diff.
+func setIssueState(ctx context.Context, state *JiraIssueResourceModel, issue *jira.Issue) diag.Diagnostics {
+	var diags, elementDiags diag.Diagnostics
+
+	state.ID = types.StringValue(issue.ID)
+	state.Key = types.StringValue(issue.Key)
+
+	fields := issue.Fields
+	if fields == nil {
+		return diags
+	}
+
+	state.Project = types.StringValue(fields.Project.Key)
+	state.IssueType = types.StringValue(fields.Type.Name)
+	state.Summary = types.StringValue(fields.Summary)
+	state.Description = stringValueOrNull(fields.Description)
+
+	state.AssigneeAccountID = types.StringNull()
+	if fields.Assignee != nil {
+		state.AssigneeAccountID = stringValueOrNull(fields.Assignee.AccountID)
+	}
+
+	// The priority name is matched ignoring case, so the configured spelling is kept when it still matches.
+	priorityName := ""
+	state.PriorityID = types.StringNull()
+	if fields.Priority != nil {
+		priorityName = fields.Priority.Name
+		state.PriorityID = stringValueOrNull(fields.Priority.ID)
+	}
+	if !strings.EqualFold(state.Priority.ValueString(), priorityName) {
+		state.Priority = stringValueOrNull(priorityName)
+	}
+
+	// Labels and components configured as an empty set stay empty rather than becoming null, see isEmptySet.
+	switch {
+	case len(fields.Labels) > 0:
+		state.Labels, elementDiags = types.SetValueFrom(ctx, types.StringType, fields.Labels)
+		diags.Append(elementDiags...)
+	case !isEmptySet(state.Labels):
+		state.Labels = types.SetNull(types.StringType)
+	}
+
+	switch {
+	case len(fields.Components) > 0:
+		componentNames := make([]string, 0, len(fields.Components))
+		for _, component := range fields.Components {
+			componentNames = append(componentNames, component.Name)
+		}
+
+		state.Components, elementDiags = types.SetValueFrom(ctx, types.StringType, componentNames)
+		diags.Append(elementDiags...)
+	case !isEmptySet(state.Components):
+		state.Components = types.SetNull(types.StringType)
+	}
+
+	state.ParentKey = types.StringNull()
+	if fields.Parent != nil {
+		state.ParentKey = stringValueOrNull(fields.Parent.Key)
+	}
+
+	return diags
+}
@@ -0,0 +1,442 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-jiracloud/internal/adf"
+)
+
+func TestIssueResource_ReparentSubtask(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	epic := fake.addIssue(projectKey, "Epic", "Release 1.0.0", "")
+	firstStory := fake.addIssue(projectKey, "Story", "Backend", epic.Key)
+	secondStory := fake.addIssue(projectKey, "Story", "Frontend", epic.Key)
+
+	state := h.create(JiraIssueResourceModel{
+		ID:                types.StringUnknown(),
+		Key:               types.StringUnknown(),
+		Project:           types.StringValue(projectKey),
+		IssueType:         types.StringValue("Sub-task"),
+		Summary:           types.StringValue("Write the release notes"),
+		Description:       types.StringNull(),
+		DescriptionFormat: types.StringValue(descriptionFormatPlain),
+		Labels:            types.SetNull(types.StringType),
+		AssigneeAccountID: types.StringNull(),
+		Priority:          types.StringUnknown(),
+		PriorityID:        types.StringUnknown(),
+		Components:        types.SetNull(types.StringType),
+		ParentKey:         types.StringValue(firstStory.Key),
+	})
+
+	var created JiraIssueResourceModel
+	h.get(state, &created)
+	if created.ParentKey.ValueString() != firstStory.Key {
+		t.Fatalf("expected the subtask to be created under %s, got: %s", firstStory.Key, created.ParentKey)
+	}
+
+	// Moving the subtask to another story is done in place.
+	plan := created
+	plan.ParentKey = types.StringValue(secondStory.Key)
+	if _, requiresReplace := h.modifyPlan(state, plan); len(requiresReplace) > 0 {
+		t.Errorf("expected moving the subtask to another story to be done in place, got replacement for: %v", requiresReplace)
+	}
+
+	state = h.update(state, plan)
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the reparented subtask to be found")
+	}
+
+	var reparented JiraIssueResourceModel
+	h.get(state, &reparented)
+	if reparented.ParentKey.ValueString() != secondStory.Key {
+		t.Errorf("expected the subtask to be moved under %s, got: %s", secondStory.Key, reparented.ParentKey)
+	}
+	if reparented.ID != created.ID {
+		t.Errorf("expected the subtask to keep the ID %s, got: %s", created.ID, reparented.ID)
+	}
+
+	// A subtask can neither be moved under an epic nor lose its parent.
+	for _, parentKey := range []types.String{types.StringValue(epic.Key), types.StringNull()} {
+		plan := reparented
+		plan.ParentKey = parentKey
+		_, requiresReplace := h.modifyPlan(state, plan)
+		if !requiresReplace.Contains(path.Root("parent_key")) {
+			t.Errorf("expected changing the parent of the subtask to %s to force a replacement", parentKey)
+		}
+	}
+}
+
+func TestIssueResource_ChangeEpic(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	epic := fake.addIssue(projectKey, "Epic", "Release 1.0.0", "")
+	story := fake.addIssue(projectKey, "Story", "Backend", "")
+
+	state := h.importState(story.Key)
+
+	var imported JiraIssueResourceModel
+	h.get(state, &imported)
+	if !imported.ParentKey.IsNull() {
+		t.Fatalf("expected the story to have no parent, got: %s", imported.ParentKey)
+	}
+
+	// Stories can be added to an epic and removed from it in place.
+	for _, parentKey := range []types.String{types.StringValue(epic.Key), types.StringNull()} {
+		plan := imported
+		plan.ParentKey = parentKey
+		if _, requiresReplace := h.modifyPlan(state, plan); len(requiresReplace) > 0 {
+			t.Errorf("expected changing the epic of the story to %s to be done in place, got replacement for: %v", parentKey, requiresReplace)
+		}
+
+		state = h.update(state, plan)
+		h.get(state, &imported)
+		if !imported.ParentKey.Equal(parentKey) {
+			t.Errorf("expected the epic of the story to be %s, got: %s", parentKey, imported.ParentKey)
+		}
+	}
+}
+
+func TestIssueResource_EmptyParentKey(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	story := fake.addIssue(projectKey, "Story", "Backend", "")
+	state := h.importState(story.Key)
+
+	var imported JiraIssueResourceModel
+	h.get(state, &imported)
+
+	plan := imported
+	plan.ParentKey = types.StringValue("")
+
+	if diags := h.validate(plan); !diags.HasError() {
+		t.Error("expected an empty parent_key to be invalid")
+	}
+
+	// Planning must not fail on a parent key that only differs from none by being empty.
+	if _, requiresReplace := h.modifyPlan(state, plan); len(requiresReplace) > 0 {
+		t.Errorf("expected an empty parent_key not to force a replacement, got replacement for: %v", requiresReplace)
+	}
+}
+
+func TestIssueResource_ADFDescriptionKeepsConfiguredText(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	// Flattening the document Jira stores drops the trailing newline, turns the bullets into dashes
+	// and separates the list from the paragraph by a blank line.
+	description := "Steps:\n* build\n* release\n"
+
+	state := h.create(JiraIssueResourceModel{
+		ID:                types.StringUnknown(),
+		Key:               types.StringUnknown(),
+		Project:           types.StringValue(projectKey),
+		IssueType:         types.StringValue("Task"),
+		Summary:           types.StringValue("Release 1.0.0"),
+		Description:       types.StringValue(description),
+		DescriptionFormat: types.StringValue(descriptionFormatADF),
+		Labels:            types.SetNull(types.StringType),
+		AssigneeAccountID: types.StringNull(),
+		Priority:          types.StringUnknown(),
+		PriorityID:        types.StringUnknown(),
+		Components:        types.SetNull(types.StringType),
+		ParentKey:         types.StringNull(),
+	})
+
+	var created JiraIssueResourceModel
+	h.get(state, &created)
+	if created.Description.ValueString() != description {
+		t.Errorf("expected the created issue to keep the configured description %q, got: %q", description, created.Description.ValueString())
+	}
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created issue to be found")
+	}
+
+	var read JiraIssueResourceModel
+	h.get(state, &read)
+	if read.Description.ValueString() != description {
+		t.Errorf("expected read to keep the configured description %q, got: %q", description, read.Description.ValueString())
+	}
+
+	// A description changed outside of Terraform is read back as flattened text.
+	fake.issue(created.Key.ValueString()).Fields.descriptionADF = adf.FromText("Steps:\n- build\n- test\n- release")
+
+	state, _ = h.read(state)
+	h.get(state, &read)
+	if want := "Steps:\n\n- build\n- test\n- release"; read.Description.ValueString() != want {
+		t.Errorf("expected read to return the changed description %q, got: %q", want, read.Description.ValueString())
+	}
+}
+
+func TestIssueResource_UpdateRetriesConflict(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	issue := fake.addIssue(projectKey, "Task", "Release 1.0.0", "")
+	state := h.importState(issue.Key)
+
+	// The first edit conflicts with a concurrent change of the issue, the next ones go through.
+	editPath := "/rest/api/2/issue/" + issue.Key
+	conflicts := 1
+	fake.handle(http.MethodPut, editPath, func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		if conflicts > 0 {
+			conflicts--
+			writeJiraError(w, http.StatusConflict, "The issue was changed by another user.")
+			return
+		}
+
+		fake.editIssue(w, r, issue.Key)
+	})
+
+	var imported JiraIssueResourceModel
+	h.get(state, &imported)
+
+	plan := imported
+	plan.Summary = types.StringValue("Release 1.0.1")
+	state = h.update(state, plan)
+
+	var updated JiraIssueResourceModel
+	h.get(state, &updated)
+	if updated.Summary.ValueString() != "Release 1.0.1" {
+		t.Errorf("expected the summary to be updated after the conflict, got: %s", updated.Summary)
+	}
+	if calls := fake.callCount(http.MethodPut, editPath); calls != 2 {
+		t.Errorf("expected the edit to be sent twice, got: %d", calls)
+	}
+}
+
+func TestIssueResource_PriorityByName(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	config := JiraIssueResourceModel{
+		ID:                types.StringUnknown(),
+		Key:               types.StringUnknown(),
+		Project:           types.StringValue(projectKey),
+		IssueType:         types.StringValue("Task"),
+		Summary:           types.StringValue("Release 1.0.0"),
+		Description:       types.StringNull(),
+		DescriptionFormat: types.StringValue(descriptionFormatPlain),
+		Labels:            types.SetNull(types.StringType),
+		AssigneeAccountID: types.StringNull(),
+		Priority:          types.StringValue("high"),
+		PriorityID:        types.StringUnknown(),
+		Components:        types.SetNull(types.StringType),
+		ParentKey:         types.StringNull(),
+	}
+	state := h.create(config)
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created issue to be found")
+	}
+
+	var read JiraIssueResourceModel
+	h.get(state, &read)
+	if read.Priority.ValueString() != "high" || read.PriorityID.ValueString() != "2" {
+		t.Errorf("expected the issue to keep the configured priority high with the ID 2, got: %s (%s)", read.Priority, read.PriorityID)
+	}
+
+	// Applying the same configuration again, spelled differently or not, plans no change of the priority.
+	for _, priority := range []string{"high", "High"} {
+		plan := read
+		plan.Priority = types.StringValue(priority)
+		plan.PriorityID = types.StringUnknown()
+
+		modified, _ := h.modifyPlan(state, plan)
+
+		var planned JiraIssueResourceModel
+		if diags := modified.Get(context.Background(), &planned); diags.HasError() {
+			t.Fatalf("reading the plan: %v", diags)
+		}
+		if planned.PriorityID.ValueString() != "2" {
+			t.Errorf("expected the priority %s to keep the priority ID 2, got: %s", priority, planned.PriorityID)
+		}
+	}
+
+	// Another priority is resolved again.
+	plan := read
+	plan.Priority = types.StringValue("Low")
+	plan.PriorityID = types.StringUnknown()
+
+	modified, _ := h.modifyPlan(state, plan)
+
+	var planned JiraIssueResourceModel
+	if diags := modified.Get(context.Background(), &planned); diags.HasError() {
+		t.Fatalf("reading the plan: %v", diags)
+	}
+	if !planned.PriorityID.IsUnknown() {
+		t.Errorf("expected changing the priority to leave the priority ID unknown, got: %s", planned.PriorityID)
+	}
+
+	state = h.update(state, planned)
+
+	var updated JiraIssueResourceModel
+	h.get(state, &updated)
+	if updated.Priority.ValueString() != "Low" || updated.PriorityID.ValueString() != "4" {
+		t.Errorf("expected the issue to have the priority Low with the ID 4, got: %s (%s)", updated.Priority, updated.PriorityID)
+	}
+}
+
+func TestIssueResource_CreateKeepsIssueWhenDescriptionFails(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	// Jira creates the issue, then rejects its ADF description.
+	var descriptionPath string
+	fake.handle(http.MethodPost, "/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		fake.createIssue(w, r)
+
+		descriptionPath = "PUT /rest/api/3/issue/" + fake.issues[len(fake.issues)-1].Key
+		fake.handlers[descriptionPath] = func(w http.ResponseWriter, r *http.Request) {
+			writeJiraError(w, http.StatusBadRequest, "The description is invalid.")
+		}
+	})
+
+	state, diags := h.tryCreate(JiraIssueResourceModel{
+		ID:                types.StringUnknown(),
+		Key:               types.StringUnknown(),
+		Project:           types.StringValue(projectKey),
+		IssueType:         types.StringValue("Task"),
+		Summary:           types.StringValue("Release 1.0.0"),
+		Description:       types.StringValue("Steps"),
+		DescriptionFormat: types.StringValue(descriptionFormatADF),
+		Labels:            types.SetNull(types.StringType),
+		AssigneeAccountID: types.StringNull(),
+		Priority:          types.StringUnknown(),
+		PriorityID:        types.StringUnknown(),
+		Components:        types.SetNull(types.StringType),
+		ParentKey:         types.StringNull(),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected the creation to fail")
+	}
+
+	var partial JiraIssueResourceModel
+	h.get(state, &partial)
+	issue := fake.issue(partial.ID.ValueString())
+	if issue == nil || issue.Key != partial.Key.ValueString() {
+		t.Fatalf("expected the state to track the created issue, got: %s (%s)", partial.Key, partial.ID)
+	}
+
+	fake.mu.Lock()
+	delete(fake.handlers, descriptionPath)
+	fake.mu.Unlock()
+
+	// The next refresh completes the state of the tracked issue.
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created issue to be found")
+	}
+
+	var read JiraIssueResourceModel
+	h.get(state, &read)
+	if read.Summary.ValueString() != "Release 1.0.0" || read.DescriptionFormat.ValueString() != descriptionFormatADF {
+		t.Errorf("expected read to return the created issue, got: %s (%s)", read.Summary, read.DescriptionFormat)
+	}
+}
+
+func TestIssueResource_EmptyLabelsAndComponents(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewIssueResource(), providerData)
+
+	empty := types.SetValueMust(types.StringType, nil)
+	state := h.create(JiraIssueResourceModel{
+		ID:                types.StringUnknown(),
+		Key:               types.StringUnknown(),
+		Project:           types.StringValue(projectKey),
+		IssueType:         types.StringValue("Task"),
+		Summary:           types.StringValue("Release 1.0.0"),
+		Description:       types.StringNull(),
+		DescriptionFormat: types.StringValue(descriptionFormatPlain),
+		Labels:            empty,
+		AssigneeAccountID: types.StringNull(),
+		Priority:          types.StringUnknown(),
+		PriorityID:        types.StringUnknown(),
+		Components:        empty,
+		ParentKey:         types.StringNull(),
+	})
+
+	// Labels and components configured as an empty set stay empty, as Terraform requires the state to match the plan.
+	var created JiraIssueResourceModel
+	h.get(state, &created)
+	if !created.Labels.Equal(empty) || !created.Components.Equal(empty) {
+		t.Errorf("expected the empty labels and components to be kept, got: %s and %s", created.Labels, created.Components)
+	}
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created issue to be found")
+	}
+
+	var read JiraIssueResourceModel
+	h.get(state, &read)
+	if !read.Labels.Equal(empty) || !read.Components.Equal(empty) {
+		t.Errorf("expected read to keep the empty labels and components, got: %s and %s", read.Labels, read.Components)
+	}
+
+	// Emptying the labels clears them.
+	plan := read
+	plan.Labels = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("release")})
+	state = h.update(state, plan)
+
+	plan.Labels = empty
+	state = h.update(state, plan)
+
+	var cleared JiraIssueResourceModel
+	h.get(state, &cleared)
+	if !cleared.Labels.Equal(empty) || len(fake.issue(cleared.Key.ValueString()).Fields.Labels) != 0 {
+		t.Errorf("expected the labels to be cleared, got: %s", cleared.Labels)
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraIssueTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraIssueTypeDataSource{}
+)
+
+func NewJiraIssueTypeDataSource() datasource.DataSource {
+	return &JiraIssueTypeDataSource{}
+}
+
+// JiraIssueTypeDataSource defines the data source implementation.
+type JiraIssueTypeDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+	projects       *projectCache
+}
+
+// issueTypeDetails is a single entry returned by the project issue types endpoint.
+// The go-jira IssueType type does not expose the hierarchy level.
+type issueTypeDetails struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Subtask        bool   `json:"subtask"`
+	HierarchyLevel int64  `json:"hierarchyLevel"`
+}
+
+func (d *JiraIssueTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+	d.projects = providerData.Projects
+}
+
+type JiraIssueTypeDataSourceModel struct {
+	Project        types.String `tfsdk:"project"`
+	Name           types.String `tfsdk:"name"`
+	ID             types.String `tfsdk:"id"`
+	Description    types.String `tfsdk:"description"`
+	Subtask        types.Bool   `tfsdk:"subtask"`
+	HierarchyLevel types.Int64  `tfsdk:"hierarchy_level"`
+}
+
+func (d *JiraIssueTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_type"
+}
+
+func (d *JiraIssueTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up an issue type available in a Jira project by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira project the issue type must be available in.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the issue type, e.g. `Story`, ignoring case.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue type.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the issue type.",
+				Computed:            true,
+			},
+			"subtask": schema.BoolAttribute{
+				MarkdownDescription: "Whether issues of this type are subtasks.",
+				Computed:            true,
+			},
+			"hierarchy_level": schema.Int64Attribute{
+				MarkdownDescription: "The level of the issue type in the issue hierarchy: `-1` for subtasks, `0` for standard issue types " +
+					"and `1` for epics.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *JiraIssueTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueTypeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := d.projects.resolve(ctx, d.client, state.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to read %s project", state.Project.ValueString()),
+			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	var issueTypes []issueTypeDetails
+	apiEndpoint := "rest/api/3/issuetype/project?projectId=" + url.QueryEscape(project.ID)
+	_, err = doJiraRequest(ctx, d.client, http.MethodGet, apiEndpoint, nil, &issueTypes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read issue types",
+			fmt.Sprintf("An unexpected error occurred while reading the issue types of the %s project... ", state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	var issueType *issueTypeDetails
+	names := make([]string, 0, len(issueTypes))
+	for i := range issueTypes {
+		if strings.EqualFold(issueTypes[i].Name, state.Name.ValueString()) {
+			issueType = &issueTypes[i]
+			break
+		}
+		names = append(names, issueTypes[i].Name)
+	}
+
+	if issueType == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Failed to find issue type",
+			fmt.Sprintf("The issue type %s is not available in the %s project. The available issue types are: %s",
+				state.Name.ValueString(), state.Project.ValueString(), strings.Join(names, ", ")),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(issueType.ID)
+	state.Description = types.StringValue(issueType.Description)
+	state.Subtask = types.BoolValue(issueType.Subtask)
+	state.HierarchyLevel = types.Int64Value(issueType.HierarchyLevel)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
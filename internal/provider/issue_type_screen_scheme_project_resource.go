@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultIssueTypeScreenSchemeID is the ID of the issue type screen scheme every Jira Cloud instance ships with.
+const defaultIssueTypeScreenSchemeID = "1"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IssueTypeScreenSchemeProjectResource{}
+	_ resource.ResourceWithConfigure   = &IssueTypeScreenSchemeProjectResource{}
+	_ resource.ResourceWithImportState = &IssueTypeScreenSchemeProjectResource{}
+)
+
+func NewIssueTypeScreenSchemeProjectResource() resource.Resource {
+	return &IssueTypeScreenSchemeProjectResource{}
+}
+
+// IssueTypeScreenSchemeProjectResource defines the resource implementation.
+type IssueTypeScreenSchemeProjectResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// issueTypeScreenSchemeAssignment is the payload accepted by the issue type screen scheme assign endpoint.
+type issueTypeScreenSchemeAssignment struct {
+	IssueTypeScreenSchemeID string `json:"issueTypeScreenSchemeId"`
+	ProjectID               string `json:"projectId"`
+}
+
+// issueTypeScreenSchemeProjects is the paginated response of the issue type screen schemes for projects endpoint.
+type issueTypeScreenSchemeProjects struct {
+	Values []struct {
+		IssueTypeScreenScheme struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"issueTypeScreenScheme"`
+		ProjectIDs []string `json:"projectIds"`
+	} `json:"values"`
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraIssueTypeScreenSchemeProjectResourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	SchemeID  types.String `tfsdk:"scheme_id"`
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_type_screen_scheme_project"
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Associates an issue type screen scheme with a company-managed Jira project. " +
+			"Destroying this resource assigns the default issue type screen scheme back to the project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue type screen scheme to assign to the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueTypeScreenSchemeProjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.assign(ctx, state.ProjectID.ValueString(), state.SchemeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to assign issue type screen scheme",
+			fmt.Sprintf("An unexpected error occurred while assigning the issue type screen scheme %s to the project %s... ", state.SchemeID.ValueString(), state.ProjectID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Assigned issue type screen scheme to project", map[string]interface{}{"scheme_id": state.SchemeID.ValueString(), "project_id": state.ProjectID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueTypeScreenSchemeProjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.assign(ctx, state.ProjectID.ValueString(), state.SchemeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to assign issue type screen scheme",
+			fmt.Sprintf("An unexpected error occurred while assigning the issue type screen scheme %s to the project %s... ", state.SchemeID.ValueString(), state.ProjectID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Assigned issue type screen scheme to project", map[string]interface{}{"scheme_id": state.SchemeID.ValueString(), "project_id": state.ProjectID.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueTypeScreenSchemeProjectResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiEndpoint := "rest/api/3/issuetypescreenscheme/project?projectId=" + url.QueryEscape(state.ProjectID.ValueString())
+	schemes := new(issueTypeScreenSchemeProjects)
+	_, err := doJiraRequest(ctx, r.client, http.MethodGet, apiEndpoint, nil, schemes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read issue type screen scheme association",
+			fmt.Sprintf("An unexpected error occurred while reading the issue type screen scheme of the project %s... ", state.ProjectID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// Team-managed or deleted projects have no issue type screen scheme at all.
+	if len(schemes.Values) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.SchemeID = types.StringValue(schemes.Values[0].IssueTypeScreenScheme.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraIssueTypeScreenSchemeProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A company-managed project always needs an issue type screen scheme, so fall back to the default one.
+	err := r.assign(ctx, state.ProjectID.ValueString(), defaultIssueTypeScreenSchemeID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to restore default issue type screen scheme",
+			fmt.Sprintf("An unexpected error occurred while assigning the default issue type screen scheme to the project %s... ", state.ProjectID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Restored default issue type screen scheme of project", map[string]interface{}{"project_id": state.ProjectID.ValueString()})
+}
+
+func (r *IssueTypeScreenSchemeProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+// assign associates the issue type screen scheme with the project.
+func (r *IssueTypeScreenSchemeProjectResource) assign(ctx context.Context, projectID, schemeID string) error {
+	payload := issueTypeScreenSchemeAssignment{
+		IssueTypeScreenSchemeID: schemeID,
+		ProjectID:               projectID,
+	}
+
+	_, err := doJiraRequest(ctx, r.client, http.MethodPut, "rest/api/3/issuetypescreenscheme/project", payload, nil)
+
+	return err
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// issueKeyPattern matches the key of an issue, i.e. the key of its project followed by its number.
+var issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]+-\d+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IssueURLFunction{}
+
+func NewIssueURLFunction() function.Function {
+	return &IssueURLFunction{}
+}
+
+// IssueURLFunction defines the function implementation.
+type IssueURLFunction struct{}
+
+func (f *IssueURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "issue_url"
+}
+
+func (f *IssueURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the URL to browse a Jira issue",
+		MarkdownDescription: "Returns the URL to browse the issue with the given key, e.g. `https://example.atlassian.net/browse/PROJ-123`. " +
+			"Functions can't read the provider configuration, so the host of the Jira Cloud instance is passed explicitly, " +
+			"or taken from the `JIRA_URL` environment variable when `null`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "host",
+				MarkdownDescription: "The hostname of the Jira Cloud instance, e.g. `https://example.atlassian.net`. " +
+					"The `https://` scheme is assumed when the hostname has none.",
+				AllowNullValue: true,
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "The key of the issue, e.g. `PROJ-123`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *IssueURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host types.String
+	var key string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &host, &key))
+
+	if resp.Error != nil {
+		return
+	}
+
+	hostValue := os.Getenv("JIRA_URL")
+	if !host.IsNull() {
+		hostValue = host.ValueString()
+	}
+
+	if hostValue == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The host is null and the JIRA_URL environment variable is not set.")
+		return
+	}
+
+	// Browsing an issue sends no credentials, so a plain http host is fine.
+	baseURL, err := normalizeHost(hostValue, true)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Jira Cloud host: "+err.Error())
+		return
+	}
+
+	if !issueKeyPattern.MatchString(key) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a valid issue key, e.g. `PROJ-123`.", key))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, baseURL+"/browse/"+key))
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls the function with the given arguments, the way Terraform would, and returns its result or error.
+// The result is of the return type of the function, unknown if the function failed.
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	definitionResp := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+
+	var result attr.Value
+	switch definitionResp.Definition.Return.(type) {
+	case function.BoolReturn:
+		result = types.BoolUnknown()
+	case function.StringReturn:
+		result = types.StringUnknown()
+	default:
+		t.Fatalf("unsupported return type %T", definitionResp.Definition.Return)
+	}
+
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestIssueURLFunction(t *testing.T) {
+	tests := []struct {
+		name    string
+		jiraURL string
+		host    types.String
+		key     string
+		want    string
+		wantErr bool
+		// wantArgument is the position of the argument the function must complain about when it fails.
+		wantArgument int64
+	}{
+		{name: "https host", host: types.StringValue("https://example.atlassian.net"), key: "PROJ-123", want: "https://example.atlassian.net/browse/PROJ-123"},
+		{name: "bare hostname", host: types.StringValue("example.atlassian.net/"), key: "AB1-7", want: "https://example.atlassian.net/browse/AB1-7"},
+		{name: "host overrides JIRA_URL", jiraURL: "https://other.atlassian.net", host: types.StringValue("example.atlassian.net"), key: "PROJ-1", want: "https://example.atlassian.net/browse/PROJ-1"},
+		{name: "null host with JIRA_URL", jiraURL: "https://example.atlassian.net/", host: types.StringNull(), key: "PROJ-123", want: "https://example.atlassian.net/browse/PROJ-123"},
+		{name: "null host without JIRA_URL", host: types.StringNull(), key: "PROJ-123", wantErr: true, wantArgument: 0},
+		{name: "invalid host", host: types.StringValue("ftp://example.atlassian.net"), key: "PROJ-123", wantErr: true, wantArgument: 0},
+		{name: "lowercase key", host: types.StringValue("example.atlassian.net"), key: "proj-123", wantErr: true, wantArgument: 1},
+		{name: "key without number", host: types.StringValue("example.atlassian.net"), key: "PROJ", wantErr: true, wantArgument: 1},
+		{name: "key without project", host: types.StringValue("example.atlassian.net"), key: "-123", wantErr: true, wantArgument: 1},
+		{name: "key with a path", host: types.StringValue("example.atlassian.net"), key: "PROJ-1/../admin", wantErr: true, wantArgument: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("JIRA_URL", test.jiraURL)
+
+			result, funcErr := runFunction(t, NewIssueURLFunction(), test.host, types.StringValue(test.key))
+			if test.wantErr {
+				if funcErr == nil {
+					t.Fatalf("expected the function to fail, got: %s", result)
+				}
+				if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != test.wantArgument {
+					t.Errorf("expected an error about argument %d, got: %+v", test.wantArgument, funcErr)
+				}
+				return
+			}
+
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !result.Equal(types.StringValue(test.want)) {
+				t.Errorf("expected %q, got: %s", test.want, result)
+			}
+		})
+	}
+}
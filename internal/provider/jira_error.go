@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// jiraErrorDetail describes an error of the Jira API client for diagnostics.
+// Unlike the error message itself, it lists all the messages and field errors Jira sent back,
+// e.g. `name: A component with that name already exists for this project.`
+func jiraErrorDetail(err error) string {
+	var jiraErr *jira.Error
+	if !errors.As(err, &jiraErr) || len(jiraErr.ErrorMessages) == 0 && len(jiraErr.Errors) == 0 {
+		return err.Error()
+	}
+
+	details := append([]string{}, jiraErr.ErrorMessages...)
+
+	fields := make([]string, 0, len(jiraErr.Errors))
+	for field := range jiraErr.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		details = append(details, fmt.Sprintf("%s: %s", field, jiraErr.Errors[field]))
+	}
+
+	detail := strings.Join(details, "; ")
+	if jiraErr.HTTPError != nil {
+		detail = fmt.Sprintf("%s (%s)", detail, jiraErr.HTTPError.Error())
+	}
+
+	// The Jira error may be wrapped with more context, which is kept.
+	return strings.Replace(err.Error(), jiraErr.Error(), detail, 1)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// doJiraRequest performs a low level request to the Jira API for endpoints that go-jira does not cover.
+// The response body is decoded into result unless it is nil, and left untouched when Jira answers with 204 No Content.
+// When result is nil, the response body is drained and closed, so the body of the returned response can't be read.
+// When Jira answers with an error status, the error holds the messages of the response body, like the go-jira services do.
+func doJiraRequest(ctx context.Context, client *jira.Client, method, apiEndpoint string, body, result interface{}) (*jira.Response, error) {
+	lowLevelRequestToJiraAPI, err := client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Do(lowLevelRequestToJiraAPI, result)
+	switch {
+	case err != nil && response != nil && (response.StatusCode < 200 || response.StatusCode > 299):
+		err = jira.NewJiraError(response, err)
+	case result != nil && errors.Is(err, io.EOF) && response.StatusCode == http.StatusNoContent:
+		err = nil
+	case result == nil && err == nil:
+		// go-jira only closes the bodies it decodes, and draining the body lets the connection be reused.
+		_, _ = io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+	}
+
+	return response, err
+}
+
+// isNotFound reports whether the Jira API answered with a 404, i.e. the requested object doesn't exist (anymore).
+func isNotFound(response *jira.Response) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
+// isConflict reports whether the Jira API answered with a 409, i.e. the object was changed concurrently.
+func isConflict(response *jira.Response) bool {
+	return response != nil && response.StatusCode == http.StatusConflict
+}
+
+// withRequestTimeout bounds the operation running with ctx by the request timeout configured on the provider.
+// The returned cancel function must be called once the operation is done.
+func withRequestTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestDoJiraRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/serverInfo":
+			writeJSON(w, http.StatusOK, map[string]string{"version": "1001.0.0"})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := jira.NewClient(server.URL, server.Client())
+	if err != nil {
+		t.Fatalf("jira.NewClient: %v", err)
+	}
+
+	// Without a result, the body is closed rather than left to the caller.
+	response, err := doJiraRequest(context.Background(), client, http.MethodGet, "rest/api/3/serverInfo", nil, nil)
+	if err != nil {
+		t.Fatalf("request without a result failed: %v", err)
+	}
+	if _, err := io.ReadAll(response.Body); err == nil {
+		t.Error("expected the body of a request without a result to be closed")
+	}
+
+	result := map[string]string{}
+	if _, err := doJiraRequest(context.Background(), client, http.MethodGet, "rest/api/3/serverInfo", nil, &result); err != nil {
+		t.Fatalf("request with a result failed: %v", err)
+	}
+	if result["version"] != "1001.0.0" {
+		t.Errorf("expected the body to be decoded into the result, got: %v", result)
+	}
+
+	// No content leaves the result untouched.
+	result = map[string]string{}
+	if _, err := doJiraRequest(context.Background(), client, http.MethodPut, "rest/agile/1.0/issue/rank", nil, &result); err != nil {
+		t.Errorf("expected no content to be accepted with a result, got: %v", err)
+	}
+	if len(result) != 0 {
+		t.Errorf("expected no content to leave the result untouched, got: %v", result)
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// labelsPageSize is the number of labels requested per page, the maximum accepted by Jira.
+const labelsPageSize = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraLabelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraLabelsDataSource{}
+)
+
+func NewJiraLabelsDataSource() datasource.DataSource {
+	return &JiraLabelsDataSource{}
+}
+
+// JiraLabelsDataSource defines the data source implementation.
+type JiraLabelsDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// labelsPage is a single page of the labels endpoint.
+type labelsPage struct {
+	IsLast bool     `json:"isLast"`
+	Values []string `json:"values"`
+}
+
+func (d *JiraLabelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraLabelsDataSourceModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+	Total  types.Int64  `tfsdk:"total"`
+	Labels []string     `tfsdk:"labels"`
+}
+
+func (d *JiraLabelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_labels"
+}
+
+func (d *JiraLabelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the issue labels used in the Jira instance, optionally only the ones starting with a prefix.",
+
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list the labels starting with this prefix. Labels are case sensitive, and so is the prefix.",
+				Optional:            true,
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "The number of labels found.",
+				Computed:            true,
+			},
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels found.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JiraLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraLabelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Labels = []string{}
+	for startAt := 0; ; startAt += labelsPageSize {
+		page := new(labelsPage)
+		apiEndpoint := fmt.Sprintf("rest/api/3/label?startAt=%d&maxResults=%d", startAt, labelsPageSize)
+		_, err := doJiraRequest(ctx, d.client, http.MethodGet, apiEndpoint, nil, page)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to list labels",
+				"An unexpected error occurred while listing the labels... "+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+
+		for _, label := range page.Values {
+			if strings.HasPrefix(label, state.Prefix.ValueString()) {
+				state.Labels = append(state.Labels, label)
+			}
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	state.Total = types.Int64Value(int64(len(state.Labels)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
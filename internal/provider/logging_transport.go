@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// loggingTransport logs every request sent to Jira and the status it got at the debug level,
+// so that `TF_LOG=DEBUG` traces the API traffic. Being below the retry transport, it logs each attempt.
+type loggingTransport struct {
+	next http.RoundTripper
+	host string
+}
+
+func newLoggingTransport(next http.RoundTripper, host string) *loggingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &loggingTransport{next: next, host: host}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.SetField(req.Context(), "jira_host", t.host)
+	ctx = tflog.SetField(ctx, "http_method", req.Method)
+	ctx = tflog.SetField(ctx, "api_endpoint", req.URL.Path)
+
+	tflog.Debug(ctx, "Sending request to Jira")
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		tflog.Debug(ctx, "Request to Jira failed", map[string]interface{}{"error": err.Error()})
+		return resp, err
+	}
+
+	tflog.Debug(ctx, "Received response from Jira", map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraNotificationEventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraNotificationEventsDataSource{}
+)
+
+func NewJiraNotificationEventsDataSource() datasource.DataSource {
+	return &JiraNotificationEventsDataSource{}
+}
+
+// JiraNotificationEventsDataSource defines the data source implementation.
+type JiraNotificationEventsDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// notificationEvent is a single entry returned by the events endpoint.
+type notificationEvent struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (d *JiraNotificationEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraNotificationEventsDataSourceModel struct {
+	Events []JiraNotificationEventModel `tfsdk:"events"`
+}
+
+type JiraNotificationEventModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *JiraNotificationEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_events"
+}
+
+func (d *JiraNotificationEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the events, both system and custom ones, that Jira can send notifications for.",
+
+		Attributes: map[string]schema.Attribute{
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "The notification events of the Jira instance.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the event.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the event.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the event.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JiraNotificationEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraNotificationEventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var events []notificationEvent
+	_, err := doJiraRequest(ctx, d.client, http.MethodGet, "rest/api/3/events", nil, &events)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read notification events",
+			"An unexpected error occurred while reading the notification events... "+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.Events = make([]JiraNotificationEventModel, 0, len(events))
+	for _, event := range events {
+		state.Events = append(state.Events, JiraNotificationEventModel{
+			ID:          types.Int64Value(event.ID),
+			Name:        types.StringValue(event.Name),
+			Description: types.StringValue(event.Description),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraPrioritiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraPrioritiesDataSource{}
+)
+
+func NewJiraPrioritiesDataSource() datasource.DataSource {
+	return &JiraPrioritiesDataSource{}
+}
+
+// JiraPrioritiesDataSource defines the data source implementation.
+type JiraPrioritiesDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+	priorities     *priorityCache
+}
+
+func (d *JiraPrioritiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+	d.priorities = providerData.Priorities
+}
+
+type JiraPrioritiesDataSourceModel struct {
+	Name       types.String        `tfsdk:"name"`
+	ID         types.String        `tfsdk:"id"`
+	Priorities []JiraPriorityModel `tfsdk:"priorities"`
+}
+
+type JiraPriorityModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	StatusColor types.String `tfsdk:"status_color"`
+	IconURL     types.String `tfsdk:"icon_url"`
+}
+
+func (d *JiraPrioritiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priorities"
+}
+
+func (d *JiraPrioritiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the issue priorities of the Jira instance, optionally narrowed down to a single priority by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the priority with this name, ignoring case. It is an error if there is no such priority.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the priority matching `name`. Only set when `name` is.",
+				Computed:            true,
+			},
+			"priorities": schema.ListNestedAttribute{
+				MarkdownDescription: "The priorities found.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the priority.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the priority.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the priority.",
+							Computed:            true,
+						},
+						"status_color": schema.StringAttribute{
+							MarkdownDescription: "The color of the priority, as a hex code.",
+							Computed:            true,
+						},
+						"icon_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the icon of the priority.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JiraPrioritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraPrioritiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorities, err := d.priorities.list(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list priorities",
+			"An unexpected error occurred while listing the priorities... "+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.ID = types.StringNull()
+	state.Priorities = []JiraPriorityModel{}
+	names := make([]string, 0, len(priorities))
+	for _, priority := range priorities {
+		names = append(names, priority.Name)
+		if !state.Name.IsNull() && !strings.EqualFold(priority.Name, state.Name.ValueString()) {
+			continue
+		}
+
+		state.Priorities = append(state.Priorities, JiraPriorityModel{
+			ID:          types.StringValue(priority.ID),
+			Name:        types.StringValue(priority.Name),
+			Description: types.StringValue(priority.Description),
+			StatusColor: types.StringValue(priority.StatusColor),
+			IconURL:     types.StringValue(priority.IconURL),
+		})
+	}
+
+	if !state.Name.IsNull() {
+		if len(state.Priorities) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Failed to find priority",
+				fmt.Sprintf("There is no priority named %s. The available priorities are: %s",
+					state.Name.ValueString(), strings.Join(names, ", ")),
+			)
+			return
+		}
+
+		state.ID = state.Priorities[0].ID
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
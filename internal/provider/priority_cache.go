@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// prioritiesPageSize is the number of priorities requested per page.
+const prioritiesPageSize = 50
+
+// prioritiesPage is a single page of the priority search endpoint.
+type prioritiesPage struct {
+	IsLast bool            `json:"isLast"`
+	Values []jira.Priority `json:"values"`
+}
+
+// priorityCache holds the priorities of the Jira instance for the duration of a Terraform operation.
+// Priorities are instance wide and rarely change, so they are fetched at most once per operation.
+type priorityCache struct {
+	mu         sync.Mutex
+	priorities []jira.Priority
+}
+
+func newPriorityCache() *priorityCache {
+	return &priorityCache{}
+}
+
+// list returns the priorities of the instance, fetching them on the first call.
+// A failed fetch is not cached.
+func (c *priorityCache) list(ctx context.Context, client *jira.Client) ([]jira.Priority, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.priorities == nil {
+		priorities, err := listPriorities(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		c.priorities = priorities
+	}
+
+	return c.priorities, nil
+}
+
+// idByName returns the ID of the priority with the given name, ignoring case.
+func (c *priorityCache) idByName(ctx context.Context, client *jira.Client, name string) (string, error) {
+	priorities, err := c.list(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(priorities))
+	for _, priority := range priorities {
+		if strings.EqualFold(priority.Name, name) {
+			return priority.ID, nil
+		}
+		names = append(names, priority.Name)
+	}
+
+	return "", fmt.Errorf("there is no priority named %q, the available priorities are: %s", name, strings.Join(names, ", "))
+}
+
+// listPriorities returns all the priorities of the instance.
+// It pages through the priority search endpoint, and falls back to the older unpaginated endpoint
+// on instances that don't offer the search yet.
+func listPriorities(ctx context.Context, client *jira.Client) ([]jira.Priority, error) {
+	priorities := []jira.Priority{}
+	for startAt := 0; ; startAt += prioritiesPageSize {
+		apiEndpoint := fmt.Sprintf("rest/api/3/priority/search?startAt=%d&maxResults=%d", startAt, prioritiesPageSize)
+
+		page := new(prioritiesPage)
+		response, err := doJiraRequest(ctx, client, http.MethodGet, apiEndpoint, nil, page)
+		if err != nil {
+			if startAt == 0 && isNotFound(response) {
+				break
+			}
+			return nil, err
+		}
+
+		priorities = append(priorities, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			return priorities, nil
+		}
+	}
+
+	_, err := doJiraRequest(ctx, client, http.MethodGet, "rest/api/3/priority", nil, &priorities)
+	if err != nil {
+		return nil, err
+	}
+
+	return priorities, nil
+}
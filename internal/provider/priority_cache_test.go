@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestPriorityCache(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	ctx := context.Background()
+	cache := newPriorityCache()
+
+	// Many issues resolving their priority only fetch the priorities once.
+	for _, test := range []struct{ name, wantID string }{
+		{"High", "2"},
+		{"high", "2"},
+		{"LOWEST", "5"},
+		{"Medium", "3"},
+	} {
+		id, err := cache.idByName(ctx, providerData.Client, test.name)
+		if err != nil {
+			t.Fatalf("resolving the priority %s: %v", test.name, err)
+		}
+		if id != test.wantID {
+			t.Errorf("expected the priority %s to have the ID %s, got: %s", test.name, test.wantID, id)
+		}
+	}
+
+	if _, err := cache.idByName(ctx, providerData.Client, "Blocker"); err == nil {
+		t.Error("expected resolving an unknown priority to fail")
+	}
+
+	if calls := fake.callCount(http.MethodGet, "/rest/api/3/priority/search"); calls != 1 {
+		t.Errorf("expected the priorities to be fetched once, got: %d", calls)
+	}
+}
+
+func TestListPriorities_FallbackWithoutSearch(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	fake.respond(http.MethodGet, "/rest/api/3/priority/search", http.StatusNotFound, fakeJiraError{ErrorMessages: []string{"Not found"}})
+
+	priorities, err := listPriorities(context.Background(), providerData.Client)
+	if err != nil {
+		t.Fatalf("listing the priorities: %v", err)
+	}
+	if len(priorities) != len(fakePriorities) {
+		t.Errorf("expected %d priorities from the unpaginated endpoint, got: %d", len(fakePriorities), len(priorities))
+	}
+	if calls := fake.callCount(http.MethodGet, "/rest/api/3/priority"); calls != 1 {
+		t.Errorf("expected the unpaginated endpoint to be called once, got: %d", calls)
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// projectRef identifies a project both ways, since some Jira endpoints want the key and others the ID.
+type projectRef struct {
+	ID  string
+	Key string
+}
+
+// projectCacheEntry holds a lookup that is either in flight or done.
+type projectCacheEntry struct {
+	done    chan struct{}
+	project *jira.Project
+	err     error
+}
+
+// projectCache holds the projects looked up during a Terraform operation.
+// It lives as long as the configured provider, so many resources of the same project only fetch it once.
+// Concurrent lookups of the same project share a single API call.
+//
+// The cached projects include their components, so the cache must be invalidated whenever a component
+// of a project is created, updated or deleted.
+type projectCache struct {
+	mu      sync.Mutex
+	entries map[string]*projectCacheEntry
+}
+
+func newProjectCache() *projectCache {
+	return &projectCache{
+		entries: make(map[string]*projectCacheEntry),
+	}
+}
+
+// get returns the project identified by keyOrID, fetching it on a cache miss.
+// The returned project is shared with other callers and must not be modified.
+// Failed lookups are not cached.
+func (c *projectCache) get(ctx context.Context, client *jira.Client, keyOrID string) (*jira.Project, error) {
+	c.mu.Lock()
+	entry, found := c.entries[keyOrID]
+	if !found {
+		entry = &projectCacheEntry{done: make(chan struct{})}
+		c.entries[keyOrID] = entry
+	}
+	c.mu.Unlock()
+
+	if found {
+		select {
+		case <-entry.done:
+			return entry.project, entry.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	project, _, err := client.Project.Get(ctx, keyOrID)
+
+	c.mu.Lock()
+	// An entry invalidated while the lookup was in flight is handed to the callers already waiting for it,
+	// but isn't stored again, since the project may have changed in the meantime.
+	stillCached := c.entries[keyOrID] == entry
+	if err != nil {
+		entry.err = err
+		if stillCached {
+			delete(c.entries, keyOrID)
+		}
+	} else {
+		entry.project = project
+		if stillCached {
+			c.entries[project.ID] = entry
+			c.entries[project.Key] = entry
+		}
+	}
+	c.mu.Unlock()
+	close(entry.done)
+
+	return entry.project, entry.err
+}
+
+// resolve returns the ID and the key of the project identified by keyOrID, fetching the project on a cache miss.
+func (c *projectCache) resolve(ctx context.Context, client *jira.Client, keyOrID string) (projectRef, error) {
+	project, err := c.get(ctx, client, keyOrID)
+	if err != nil {
+		return projectRef{}, err
+	}
+
+	return projectRef{ID: project.ID, Key: project.Key}, nil
+}
+
+// invalidate drops the project identified by keyOrID from the cache, whether it was looked up by key or by ID.
+func (c *projectCache) invalidate(keyOrID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for cacheKey, entry := range c.entries {
+		if cacheKey == keyOrID || entry.project != nil && (entry.project.ID == keyOrID || entry.project.Key == keyOrID) {
+			delete(c.entries, cacheKey)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestProjectCache(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	ctx := context.Background()
+	cache := newProjectCache()
+	project := fake.project(projectKey)
+	projectPath := "/rest/api/2/project/" + projectKey
+
+	// Concurrent lookups share a single fetch of the project.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.resolve(ctx, providerData.Client, projectKey); err != nil {
+				t.Errorf("resolving the project: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The project is cached by its ID too.
+	ref, err := cache.resolve(ctx, providerData.Client, project.ID)
+	if err != nil {
+		t.Fatalf("resolving the project by ID: %v", err)
+	}
+	if ref.ID != project.ID || ref.Key != projectKey {
+		t.Errorf("expected the project %s with ID %s, got: %+v", projectKey, project.ID, ref)
+	}
+
+	if calls := fake.callCount(http.MethodGet, projectPath); calls != 1 {
+		t.Errorf("expected the project to be fetched once, got: %d", calls)
+	}
+	if calls := fake.callCount(http.MethodGet, "/rest/api/2/project/"+project.ID); calls != 0 {
+		t.Errorf("expected the project not to be fetched by ID, got: %d", calls)
+	}
+
+	// An invalidated project is fetched again.
+	cache.invalidate(project.ID)
+	if _, err := cache.resolve(ctx, providerData.Client, projectKey); err != nil {
+		t.Fatalf("resolving the invalidated project: %v", err)
+	}
+	if calls := fake.callCount(http.MethodGet, projectPath); calls != 2 {
+		t.Errorf("expected the invalidated project to be fetched again, got %d fetches", calls)
+	}
+
+	// Failed lookups are not cached.
+	for i := 0; i < 2; i++ {
+		if _, err := cache.resolve(ctx, providerData.Client, "MISSING"); err == nil {
+			t.Error("expected resolving a missing project to fail")
+		}
+	}
+	if calls := fake.callCount(http.MethodGet, "/rest/api/2/project/MISSING"); calls != 2 {
+		t.Errorf("expected the missing project to be fetched on every lookup, got %d fetches", calls)
+	}
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraProjectCategoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraProjectCategoryDataSource{}
+)
+
+func NewJiraProjectCategoryDataSource() datasource.DataSource {
+	return &JiraProjectCategoryDataSource{}
+}
+
+// JiraProjectCategoryDataSource defines the data source implementation.
+type JiraProjectCategoryDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+func (d *JiraProjectCategoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraProjectCategoryDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *JiraProjectCategoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_category"
+}
+
+func (d *JiraProjectCategoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a Jira project category by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project category, ignoring case.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project category.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the project category.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JiraProjectCategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectCategoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var categories []projectCategoryDetails
+	_, err := doJiraRequest(ctx, d.client, http.MethodGet, "rest/api/3/projectCategory", nil, &categories)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read project categories",
+			"An unexpected error occurred while reading the project categories... "+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	var category *projectCategoryDetails
+	names := make([]string, 0, len(categories))
+	for i := range categories {
+		if strings.EqualFold(categories[i].Name, state.Name.ValueString()) {
+			category = &categories[i]
+			break
+		}
+		names = append(names, categories[i].Name)
+	}
+
+	if category == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Failed to find project category",
+			fmt.Sprintf("There is no project category named %s. The existing project categories are: %s",
+				state.Name.ValueString(), strings.Join(names, ", ")),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(category.ID)
+	state.Description = types.StringValue(category.Description)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
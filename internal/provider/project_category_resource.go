@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ProjectCategoryResource{}
+	_ resource.ResourceWithConfigure   = &ProjectCategoryResource{}
+	_ resource.ResourceWithImportState = &ProjectCategoryResource{}
+)
+
+func NewProjectCategoryResource() resource.Resource {
+	return &ProjectCategoryResource{}
+}
+
+// ProjectCategoryResource defines the resource implementation.
+type ProjectCategoryResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// projectCategoryOptions is the payload accepted by the project category create and update endpoints.
+type projectCategoryOptions struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// projectCategoryDetails is the representation of a project category returned by the project category endpoints.
+type projectCategoryDetails struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (r *ProjectCategoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraProjectCategoryResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *ProjectCategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_category"
+}
+
+func (r *ProjectCategoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Project Category Resource, used to group projects. " +
+			"Assign projects to the category with the `category_id` of the `jiracloud_project` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira project category.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira project category. It must be unique.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Jira project category.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectCategoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := projectCategoryOptions{
+		Name:        state.Name.ValueString(),
+		Description: state.Description.ValueString(),
+	}
+
+	newCategory := new(projectCategoryDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPost, "rest/api/3/projectCategory", options, newCategory)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create project category",
+			fmt.Sprintf("An unexpected error occurred while creating a new project category named %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	setProjectCategoryState(&state, newCategory)
+
+	tflog.Trace(ctx, "Created project category", map[string]interface{}{"project_category_id": newCategory.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectCategoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := projectCategoryOptions{
+		Name:        state.Name.ValueString(),
+		Description: state.Description.ValueString(),
+	}
+
+	updatedCategory := new(projectCategoryDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/projectCategory/%s", state.ID.ValueString()), options, updatedCategory)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update project category",
+			fmt.Sprintf("An unexpected error occurred while updating the project category %s... ", state.ID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	setProjectCategoryState(&state, updatedCategory)
+
+	tflog.Trace(ctx, "Updated project category", map[string]interface{}{"project_category_id": updatedCategory.ID})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectCategoryResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category := new(projectCategoryDetails)
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/projectCategory/%s", state.ID.ValueString()), nil, category)
+	if err != nil {
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read project category",
+			fmt.Sprintf("An unexpected error occurred while reading the project category %s... ", state.ID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	setProjectCategoryState(&state, category)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectCategoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/projectCategory/%s", state.ID.ValueString()), nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete project category",
+			fmt.Sprintf("An unexpected error occurred while deleting the project category %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted project category", map[string]interface{}{"project_category_id": state.ID.ValueString()})
+}
+
+func (r *ProjectCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setProjectCategoryState copies the attributes returned by Jira into the model.
+func setProjectCategoryState(state *JiraProjectCategoryResourceModel, category *projectCategoryDetails) {
+	state.ID = types.StringValue(category.ID)
+	state.Name = types.StringValue(category.Name)
+	state.Description = types.StringValue(category.Description)
+}
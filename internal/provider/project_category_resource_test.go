@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectCategoryResource_CRUD(t *testing.T) {
+	providerData, _, _ := testJira(t)
+	h := newResourceHarness(t, NewProjectCategoryResource(), providerData)
+
+	name := fmt.Sprintf("tf-test-%d", time.Now().UnixNano())
+
+	// Create
+	state := h.create(JiraProjectCategoryResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue(name),
+		Description: types.StringValue("Created by Terraform"),
+	})
+
+	var created JiraProjectCategoryResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() == "" {
+		t.Fatal("expected the created project category to have an ID")
+	}
+	if created.Name.ValueString() != name || created.Description.ValueString() != "Created by Terraform" {
+		t.Errorf("unexpected created project category: %+v", created)
+	}
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created project category to be found")
+	}
+
+	var read JiraProjectCategoryResourceModel
+	h.get(state, &read)
+	if read != created {
+		t.Errorf("expected read to return the created project category\ncreated: %+v\nread:    %+v", created, read)
+	}
+
+	// Rename
+	plan := read
+	plan.Name = types.StringValue(name + "-renamed")
+	state = h.update(state, plan)
+
+	var updated JiraProjectCategoryResourceModel
+	h.get(state, &updated)
+	if updated.ID != created.ID {
+		t.Errorf("expected the update to keep the ID %s, got: %s", created.ID, updated.ID)
+	}
+	if updated.Name.ValueString() != name+"-renamed" || updated.Description != created.Description {
+		t.Errorf("unexpected updated project category: %+v", updated)
+	}
+
+	state, found = h.read(state)
+	if !found {
+		t.Fatal("expected the renamed project category to be found")
+	}
+
+	h.get(state, &read)
+	if read != updated {
+		t.Errorf("expected read to return the renamed project category\nupdated: %+v\nread:    %+v", updated, read)
+	}
+
+	// Delete
+	h.delete(state)
+
+	if _, found := h.read(state); found {
+		t.Error("expected the deleted project category to be removed from the state")
+	}
+}
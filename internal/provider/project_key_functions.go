@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// projectKeyPattern matches the keys Jira accepts for projects by default:
+// 2 to 10 uppercase letters and digits, starting with a letter.
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ function.Function = &IsValidProjectKeyFunction{}
+	_ function.Function = &NormalizeProjectKeyFunction{}
+)
+
+func NewIsValidProjectKeyFunction() function.Function {
+	return &IsValidProjectKeyFunction{}
+}
+
+// IsValidProjectKeyFunction defines the function implementation.
+type IsValidProjectKeyFunction struct{}
+
+func (f *IsValidProjectKeyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_project_key"
+}
+
+func (f *IsValidProjectKeyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether a string is a valid Jira project key",
+		MarkdownDescription: "Returns whether the string is a valid Jira project key, i.e. 2 to 10 uppercase letters and digits " +
+			"starting with a letter, e.g. `PROJ`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "The string to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsValidProjectKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &key))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, projectKeyPattern.MatchString(key)))
+}
+
+func NewNormalizeProjectKeyFunction() function.Function {
+	return &NormalizeProjectKeyFunction{}
+}
+
+// NormalizeProjectKeyFunction defines the function implementation.
+type NormalizeProjectKeyFunction struct{}
+
+func (f *NormalizeProjectKeyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_project_key"
+}
+
+func (f *NormalizeProjectKeyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Turns a string into a Jira project key",
+		MarkdownDescription: "Returns the string trimmed and uppercased, e.g. `PROJ` for ` proj `. " +
+			"It is an error if the result is not a valid Jira project key, i.e. 2 to 10 uppercase letters and digits starting with a letter.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "The string to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeProjectKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &key))
+
+	if resp.Error != nil {
+		return
+	}
+
+	normalizedKey := strings.ToUpper(strings.TrimSpace(key))
+	if !projectKeyPattern.MatchString(normalizedKey) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid project key: it must be 2 to 10 letters and digits, starting with a letter.", key))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalizedKey))
+}
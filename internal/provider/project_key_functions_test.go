@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsValidProjectKeyFunction(t *testing.T) {
+	tests := map[string]bool{
+		"PROJ":        true,
+		"AB":          true,
+		"ABC123":      true,
+		"ABCDEFGHIJ":  true,
+		"proj":        false,
+		"Proj":        false,
+		"1PROJ":       false,
+		"A":           false,
+		"ABCDEFGHIJK": false,
+		"PR-OJ":       false,
+		" PROJ":       false,
+		"":            false,
+	}
+
+	for key, want := range tests {
+		t.Run(key, func(t *testing.T) {
+			result, funcErr := runFunction(t, NewIsValidProjectKeyFunction(), types.StringValue(key))
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !result.Equal(types.BoolValue(want)) {
+				t.Errorf("expected is_valid_project_key(%q) to be %t, got: %s", key, want, result)
+			}
+		})
+	}
+}
+
+func TestNormalizeProjectKeyFunction(t *testing.T) {
+	tests := []struct {
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{key: "PROJ", want: "PROJ"},
+		{key: "proj", want: "PROJ"},
+		{key: " abc1 ", want: "ABC1"},
+		{key: "abcdefghij", want: "ABCDEFGHIJ"},
+		{key: "1proj", wantErr: true},
+		{key: "abcdefghijk", wantErr: true},
+		{key: "a", wantErr: true},
+		{key: "pr oj", wantErr: true},
+		{key: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			result, funcErr := runFunction(t, NewNormalizeProjectKeyFunction(), types.StringValue(test.key))
+			if test.wantErr {
+				if funcErr == nil {
+					t.Fatalf("expected normalize_project_key(%q) to fail, got: %s", test.key, result)
+				}
+				if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+					t.Errorf("expected an error about the key argument, got: %+v", funcErr)
+				}
+				return
+			}
+
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+			if !result.Equal(types.StringValue(test.want)) {
+				t.Errorf("expected normalize_project_key(%q) to be %q, got: %s", test.key, test.want, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,505 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ProjectResource{}
+	_ resource.ResourceWithConfigure      = &ProjectResource{}
+	_ resource.ResourceWithImportState    = &ProjectResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectResource{}
+)
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// ProjectResource defines the resource implementation.
+type ProjectResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+	projects       *projectCache
+}
+
+// projectCreateOptions is the payload accepted by the project create endpoint.
+type projectCreateOptions struct {
+	Key                string `json:"key"`
+	Name               string `json:"name"`
+	Description        string `json:"description,omitempty"`
+	LeadAccountId      string `json:"leadAccountId"`
+	ProjectTypeKey     string `json:"projectTypeKey"`
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty"`
+	AssigneeType       string `json:"assigneeType,omitempty"`
+	CategoryID         int64  `json:"categoryId,omitempty"`
+}
+
+// projectCreated is the response of the project create endpoint, which returns the project ID as a number.
+type projectCreated struct {
+	ID  json.Number `json:"id"`
+	Key string      `json:"key"`
+}
+
+// projectUpdateOptions is the payload accepted by the project update endpoint.
+type projectUpdateOptions struct {
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description"`
+	LeadAccountId string `json:"leadAccountId,omitempty"`
+	AssigneeType  string `json:"assigneeType,omitempty"`
+	// CategoryID is only sent when the category changes, -1 removing the project from its category.
+	CategoryID *int64 `json:"categoryId,omitempty"`
+}
+
+// projectRemoveCategory is the category ID that removes a project from its category on update.
+const projectRemoveCategory = -1
+
+// projectDetails holds the project attributes this provider manages.
+// The go-jira Project type does not expose the project type key.
+// Jira nests the lead in a user object, of which only the account ID is kept.
+type projectDetails struct {
+	ID             string    `json:"id"`
+	Key            string    `json:"key"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Lead           jira.User `json:"lead"`
+	ProjectTypeKey string    `json:"projectTypeKey"`
+	AssigneeType   string    `json:"assigneeType"`
+	// ProjectCategory is nil if the project is not in a category.
+	ProjectCategory *projectCategoryDetails `json:"projectCategory"`
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+	r.projects = providerData.Projects
+}
+
+type JiraProjectResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Key                types.String `tfsdk:"key"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	LeadAccountID      types.String `tfsdk:"lead_account_id"`
+	ProjectTypeKey     types.String `tfsdk:"project_type_key"`
+	ProjectTemplateKey types.String `tfsdk:"project_template_key"`
+	AssigneeType       types.String `tfsdk:"assignee_type"`
+	CategoryID         types.String `tfsdk:"category_id"`
+	URL                types.String `tfsdk:"url"`
+	CheckLead          types.Bool   `tfsdk:"check_lead_assignable"`
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Project Resource. Destroying a project moves it to the Jira recycle bin, " +
+			"so its key stays taken until the project is deleted permanently.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira project, e.g. `ABC`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira project.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Jira project.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"lead_account_id": schema.StringAttribute{
+				MarkdownDescription: "The lead of the Jira project represented by their Jira account ID. " +
+					"Jira projects always have a lead, so it can be changed but not cleared.",
+				Required: true,
+				Validators: []validator.String{
+					isAccountID(),
+				},
+			},
+			"project_type_key": schema.StringAttribute{
+				MarkdownDescription: "The type of the Jira project, e.g. `software`, `service_desk` or `business`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_template_key": schema.StringAttribute{
+				MarkdownDescription: "The template the Jira project is created from, " +
+					"e.g. `com.pyxis.greenhopper.jira:gh-simplified-kanban-classic`. Only used when the project is created, " +
+					"so changing it replaces the project, unless the project was imported.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Jira doesn't return the template of a project, so imported projects have none in the state.
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the template replaces the project, unless the project was imported.",
+						"Changing the template replaces the project, unless the project was imported.",
+					),
+				},
+			},
+			"assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The default assignee of issues created in the Jira project. " +
+					"Valid values are `PROJECT_LEAD`, `UNASSIGNED`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PROJECT_LEAD", "UNASSIGNED"),
+				},
+			},
+			"category_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project category the Jira project belongs to, " +
+					"e.g. the `id` of a `jiracloud_project_category` resource. If not set, the project is not in any category.",
+				Optional: true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Jira project in the Jira web interface.",
+				Computed:            true,
+			},
+			"check_lead_assignable": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check that a new `lead_account_id` is assignable in the project before changing the lead. " +
+					"This turns a late failure of the update into a clear error, at the cost of an extra API call.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraProjectResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Jira ignores an empty lead on update rather than clearing it, so the change would never converge.
+	if !config.LeadAccountID.IsUnknown() && strings.TrimSpace(config.LeadAccountID.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("lead_account_id"),
+			"Missing project lead",
+			"Jira projects must have a lead, so `lead_account_id` can't be empty. "+
+				"Set it to the Jira account ID of the new lead instead of clearing it.",
+		)
+	}
+
+	if !config.CategoryID.IsNull() && !config.CategoryID.IsUnknown() {
+		if _, err := strconv.ParseInt(config.CategoryID.ValueString(), 10, 64); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("category_id"),
+				"Invalid project category ID",
+				fmt.Sprintf("The value %q is not a project category ID. Project category IDs are numbers, e.g. `10000`.", config.CategoryID.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := projectCreateOptions{
+		Key:                state.Key.ValueString(),
+		Name:               state.Name.ValueString(),
+		Description:        state.Description.ValueString(),
+		LeadAccountId:      state.LeadAccountID.ValueString(),
+		ProjectTypeKey:     state.ProjectTypeKey.ValueString(),
+		ProjectTemplateKey: state.ProjectTemplateKey.ValueString(),
+		AssigneeType:       state.AssigneeType.ValueString(),
+	}
+
+	if !state.CategoryID.IsNull() {
+		// The category ID was validated with the configuration.
+		options.CategoryID, _ = strconv.ParseInt(state.CategoryID.ValueString(), 10, 64)
+	}
+
+	newProject := new(projectCreated)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPost, "rest/api/3/project", options, newProject)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create project",
+			fmt.Sprintf("An unexpected error occurred while creating a new project with the key %s... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// The create endpoint only answers with the ID and the key of the new project.
+	project := new(projectDetails)
+	_, err = doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/project/%s?expand=lead", newProject.ID.String()), nil, project)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read project",
+			fmt.Sprintf("An unexpected error occurred while reading the newly created %s project... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	r.setState(&state, project)
+
+	tflog.Trace(ctx, "Created project", map[string]interface{}{"project_id": project.ID, "project": state.Key.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state, priorState JiraProjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.CheckLead.ValueBool() && !state.LeadAccountID.Equal(priorState.LeadAccountID) {
+		assignable, err := r.isAssignable(ctx, state.Key.ValueString(), state.LeadAccountID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to check project lead",
+				fmt.Sprintf("An unexpected error occurred while checking whether the account %s is assignable in the %s project... ", state.LeadAccountID.ValueString(), state.Key.ValueString())+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+
+		if !assignable {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("lead_account_id"),
+				"Project lead is not assignable",
+				fmt.Sprintf("The account %s can't be assigned issues in the %s project, so Jira won't accept it as the project lead. ", state.LeadAccountID.ValueString(), state.Key.ValueString())+
+					"Grant the account the Assignable User permission in the project, e.g. through a project role, before making it the lead.",
+			)
+			return
+		}
+	}
+
+	options := projectUpdateOptions{
+		Name:          state.Name.ValueString(),
+		Description:   state.Description.ValueString(),
+		LeadAccountId: state.LeadAccountID.ValueString(),
+		AssigneeType:  state.AssigneeType.ValueString(),
+	}
+
+	if !state.CategoryID.Equal(priorState.CategoryID) {
+		categoryID := int64(projectRemoveCategory)
+		if !state.CategoryID.IsNull() {
+			// The category ID was validated with the configuration.
+			categoryID, _ = strconv.ParseInt(state.CategoryID.ValueString(), 10, 64)
+		}
+		options.CategoryID = &categoryID
+	}
+
+	updatedProject := new(projectDetails)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/project/%s?expand=lead", state.ID.ValueString()), options, updatedProject)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update project",
+			fmt.Sprintf("An unexpected error occurred while updating the %s project... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	r.projects.invalidate(state.ID.ValueString())
+	r.setState(&state, updatedProject)
+
+	tflog.Trace(ctx, "Updated project", map[string]interface{}{"project_id": updatedProject.ID, "project": state.Key.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID is missing right after an import by key.
+	projectIDOrKey := state.ID.ValueString()
+	if projectIDOrKey == "" {
+		projectIDOrKey = state.Key.ValueString()
+	}
+
+	project := new(projectDetails)
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, fmt.Sprintf("rest/api/3/project/%s?expand=lead", projectIDOrKey), nil, project)
+	if err != nil {
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to read %s project", state.Key.ValueString()),
+			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	r.setState(&state, project)
+
+	// Imported projects have no value for the provider-only settings yet.
+	if state.CheckLead.IsNull() {
+		state.CheckLead = types.BoolValue(false)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, fmt.Sprintf("rest/api/3/project/%s", state.ID.ValueString()), nil, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete project",
+			fmt.Sprintf("An unexpected error occurred while deleting the %s project... ", state.Key.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	r.projects.invalidate(state.ID.ValueString())
+
+	tflog.Trace(ctx, "Deleted project", map[string]interface{}{"project_id": state.ID.ValueString(), "project": state.Key.ValueString()})
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+// setState copies the attributes returned by Jira into the model.
+// The project template key is not returned by Jira, so it is kept as configured.
+func (r *ProjectResource) setState(state *JiraProjectResourceModel, project *projectDetails) {
+	browseURL := r.client.BaseURL.ResolveReference(&url.URL{Path: "browse/" + project.Key})
+
+	state.ID = types.StringValue(project.ID)
+	state.Key = types.StringValue(project.Key)
+	state.Name = types.StringValue(project.Name)
+	state.Description = types.StringValue(project.Description)
+	state.LeadAccountID = types.StringValue(project.Lead.AccountID)
+	state.ProjectTypeKey = types.StringValue(project.ProjectTypeKey)
+	state.AssigneeType = types.StringValue(project.AssigneeType)
+	state.URL = types.StringValue(browseURL.String())
+
+	if project.ProjectCategory != nil {
+		state.CategoryID = types.StringValue(project.ProjectCategory.ID)
+	} else {
+		state.CategoryID = types.StringNull()
+	}
+}
+
+// isAssignable reports whether issues of the project can be assigned to the given account.
+func (r *ProjectResource) isAssignable(ctx context.Context, projectKey, accountID string) (bool, error) {
+	query := url.Values{}
+	query.Set("project", projectKey)
+	query.Set("accountId", accountID)
+
+	var users []jira.User
+	_, err := doJiraRequest(ctx, r.client, http.MethodGet, "rest/api/3/user/assignable/search?"+query.Encode(), nil, &users)
+	if err != nil {
+		return false, err
+	}
+
+	for _, user := range users {
+		if user.AccountID == accountID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
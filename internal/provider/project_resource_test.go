@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testProjectKey returns a project key that is unlikely to be taken, for a scratch project.
+func testProjectKey() string {
+	return fmt.Sprintf("TF%06d", time.Now().UnixNano()%1000000)
+}
+
+// testProjectLead returns the account ID of the user the provider authenticates as, who can lead scratch projects.
+func testProjectLead(t *testing.T, providerData *JiraCloudProviderData) string {
+	t.Helper()
+
+	user, _, err := providerData.Client.User.GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("reading the current user: %v", err)
+	}
+
+	return user.AccountID
+}
+
+// testProjectPlan returns the plan of a scratch software project led by the given user.
+func testProjectPlan(key, leadAccountID string) JiraProjectResourceModel {
+	return JiraProjectResourceModel{
+		ID:                 types.StringUnknown(),
+		Key:                types.StringValue(key),
+		Name:               types.StringValue("Terraform " + key),
+		Description:        types.StringValue("Created by Terraform"),
+		LeadAccountID:      types.StringValue(leadAccountID),
+		ProjectTypeKey:     types.StringValue("software"),
+		ProjectTemplateKey: types.StringValue("com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"),
+		AssigneeType:       types.StringUnknown(),
+		CategoryID:         types.StringNull(),
+		URL:                types.StringUnknown(),
+		CheckLead:          types.BoolValue(false),
+	}
+}
+
+func TestProjectResource_CreateDestroy(t *testing.T) {
+	providerData, _, _ := testJira(t)
+	h := newResourceHarness(t, NewProjectResource(), providerData)
+
+	key := testProjectKey()
+	lead := testProjectLead(t, providerData)
+
+	// Create
+	state := h.create(testProjectPlan(key, lead))
+
+	var created JiraProjectResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() == "" || created.URL.ValueString() == "" {
+		t.Fatalf("expected the created project to have an ID and a URL, got: %+v", created)
+	}
+	if created.Key.ValueString() != key || created.LeadAccountID.ValueString() != lead || created.ProjectTypeKey.ValueString() != "software" {
+		t.Errorf("unexpected created project: %+v", created)
+	}
+
+	// Read
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created project to be found")
+	}
+
+	var read JiraProjectResourceModel
+	h.get(state, &read)
+	if read != created {
+		t.Errorf("expected read to return the created project\ncreated: %+v\nread:    %+v", created, read)
+	}
+
+	// Update
+	plan := read
+	plan.Name = types.StringValue("Terraform " + key + " renamed")
+	plan.Description = types.StringValue("Updated by Terraform")
+	state = h.update(state, plan)
+
+	var updated JiraProjectResourceModel
+	h.get(state, &updated)
+	if updated.ID != created.ID || updated.Name != plan.Name || updated.Description != plan.Description {
+		t.Errorf("unexpected updated project: %+v", updated)
+	}
+
+	// Delete
+	h.delete(state)
+
+	if _, found := h.read(state); found {
+		t.Error("expected the deleted project to be removed from the state")
+	}
+}
+
+func TestProjectResource_ImportKeepsTemplate(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewProjectResource(), providerData)
+
+	state := h.importState(projectKey)
+
+	var imported JiraProjectResourceModel
+	h.get(state, &imported)
+	if !imported.ProjectTemplateKey.IsNull() {
+		t.Fatalf("expected the imported project to have no template, got: %s", imported.ProjectTemplateKey)
+	}
+
+	// The template of the configuration can't be compared with the one the project was created from.
+	plan := imported
+	plan.ProjectTemplateKey = types.StringValue("com.pyxis.greenhopper.jira:gh-simplified-kanban-classic")
+	if _, requiresReplace := h.modifyPlan(state, plan); len(requiresReplace) > 0 {
+		t.Errorf("expected setting the template of an imported project not to replace it, got replacement for: %v", requiresReplace)
+	}
+
+	state = h.update(state, plan)
+
+	// Once known, a change of the template does replace the project.
+	h.get(state, &imported)
+	plan = imported
+	plan.ProjectTemplateKey = types.StringValue("com.pyxis.greenhopper.jira:gh-simplified-scrum-classic")
+	if _, requiresReplace := h.modifyPlan(state, plan); !requiresReplace.Contains(path.Root("project_template_key")) {
+		t.Error("expected changing the template of the project to replace it")
+	}
+}
+
+func TestProjectResource_ValidateAssigneeType(t *testing.T) {
+	providerData, _, _ := testJira(t)
+	h := newResourceHarness(t, NewProjectResource(), providerData)
+
+	for assigneeType, wantErr := range map[string]bool{
+		"PROJECT_LEAD":    false,
+		"UNASSIGNED":      false,
+		"COMPONENT_LEAD":  true,
+		"PROJECT_DEFAULT": true,
+		"unassigned":      true,
+	} {
+		config := testProjectPlan("TFVALID", "557058:terraform")
+		config.ID = types.StringNull()
+		config.URL = types.StringNull()
+		config.AssigneeType = types.StringValue(assigneeType)
+
+		if diags := h.validate(config); diags.HasError() != wantErr {
+			t.Errorf("assignee_type %q: expected an error: %v, got: %v", assigneeType, wantErr, diags)
+		}
+	}
+}
+
+func TestProjectResource_UnassignableLead(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewProjectResource(), providerData)
+
+	project := fake.project(projectKey)
+	previousLead := project.Lead.AccountID
+	lead := fake.addUser("557058:contractor", "contractor@example.com", "Contractor")
+	fake.setUnassignable(lead.AccountID)
+
+	state := h.importState(projectKey)
+
+	var imported JiraProjectResourceModel
+	h.get(state, &imported)
+
+	// With the check, the lead is refused before the project is changed.
+	plan := imported
+	plan.LeadAccountID = types.StringValue(lead.AccountID)
+	plan.CheckLead = types.BoolValue(true)
+
+	_, diags := h.tryUpdate(state, plan)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Project lead is not assignable" {
+		t.Fatalf("expected the unassignable lead to be refused, got: %v", diags)
+	}
+	if calls := fake.callCount(http.MethodPut, "/rest/api/3/project/"+project.ID); calls != 0 {
+		t.Errorf("expected the project not to be updated, got %d updates", calls)
+	}
+
+	// Without it, Jira refuses the lead itself.
+	plan.CheckLead = types.BoolValue(false)
+	if _, diags := h.tryUpdate(state, plan); !diags.HasError() {
+		t.Error("expected Jira to refuse the unassignable lead")
+	}
+
+	if project.Lead.AccountID != previousLead {
+		t.Errorf("expected the project to keep the lead %s, got: %s", previousLead, project.Lead.AccountID)
+	}
+}
+
+func TestProjectResource_Lead(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewProjectResource(), providerData)
+
+	key := testProjectKey()
+	lead := testProjectLead(t, providerData)
+	newLead := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+
+	// Set
+	state := h.create(testProjectPlan(key, lead))
+
+	var created JiraProjectResourceModel
+	h.get(state, &created)
+	if created.LeadAccountID.ValueString() != lead {
+		t.Fatalf("expected the project to be led by %s, got: %s", lead, created.LeadAccountID)
+	}
+
+	// Change
+	plan := created
+	plan.LeadAccountID = types.StringValue(newLead.AccountID)
+	state = h.update(state, plan)
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the project to be found")
+	}
+
+	var updated JiraProjectResourceModel
+	h.get(state, &updated)
+	if updated.LeadAccountID.ValueString() != newLead.AccountID {
+		t.Errorf("expected the project to be led by %s, got: %s", newLead.AccountID, updated.LeadAccountID)
+	}
+
+	// Clear, which Jira would silently ignore.
+	for _, leadAccountID := range []string{"", "  "} {
+		config := updated
+		config.LeadAccountID = types.StringValue(leadAccountID)
+		if diags := h.validate(config); !diags.HasError() {
+			t.Errorf("expected clearing the project lead with %q to be invalid", leadAccountID)
+		}
+	}
+}
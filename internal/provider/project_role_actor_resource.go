@@ -0,0 +1,441 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// userRoleActorType is the type of the role actors that are users.
+	userRoleActorType = "atlassian-user-role-actor"
+	// groupRoleActorType is the type of the role actors that are groups.
+	groupRoleActorType = "atlassian-group-role-actor"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ProjectRoleActorResource{}
+	_ resource.ResourceWithConfigure      = &ProjectRoleActorResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectRoleActorResource{}
+)
+
+func NewProjectRoleActorResource() resource.Resource {
+	return &ProjectRoleActorResource{}
+}
+
+// ProjectRoleActorResource defines the resource implementation.
+type ProjectRoleActorResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// projectRoleActorsAddOptions is the payload accepted by the add actors to project role endpoint.
+type projectRoleActorsAddOptions struct {
+	User    []string `json:"user,omitempty"`
+	GroupID []string `json:"groupId,omitempty"`
+}
+
+// projectRoleDetails is a role of a project along with its actors.
+type projectRoleDetails struct {
+	Actors []struct {
+		Type      string `json:"type"`
+		ActorUser struct {
+			AccountID string `json:"accountId"`
+		} `json:"actorUser"`
+		ActorGroup struct {
+			GroupID string `json:"groupId"`
+		} `json:"actorGroup"`
+	} `json:"actors"`
+}
+
+func (r *ProjectRoleActorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraProjectRoleActorResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Project    types.String `tfsdk:"project"`
+	RoleID     types.String `tfsdk:"role_id"`
+	AccountIDs types.Set    `tfsdk:"account_ids"`
+	GroupIDs   types.Set    `tfsdk:"group_ids"`
+}
+
+func (r *ProjectRoleActorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_role_actor"
+}
+
+func (r *ProjectRoleActorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages users and groups acting in a role of a Jira project. " +
+			"Only the listed actors are managed, other actors of the role are left alone.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the resource, in the format of `project:role_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project role, e.g. from the `jiracloud_role` data source.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_ids": schema.SetAttribute{
+				MarkdownDescription: "The Jira account IDs of the users acting in the role. At least one of `account_ids` and `group_ids` must be set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(isAccountID()),
+				},
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the groups acting in the role. At least one of `account_ids` and `group_ids` must be set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectRoleActorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraProjectRoleActorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.AccountIDs.IsUnknown() || config.GroupIDs.IsUnknown() {
+		return
+	}
+
+	if config.AccountIDs.IsNull() && config.GroupIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("account_ids"),
+			"Missing role actors",
+			"At least one of `account_ids` and `group_ids` must be set.",
+		)
+	}
+}
+
+func (r *ProjectRoleActorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectRoleActorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var options projectRoleActorsAddOptions
+	resp.Diagnostics.Append(stringSetElements(ctx, state.AccountIDs, &options.User)...)
+	resp.Diagnostics.Append(stringSetElements(ctx, state.GroupIDs, &options.GroupID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.addActors(ctx, &state, options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to add role actors",
+			fmt.Sprintf("An unexpected error occurred while adding actors to the role %s of the %s project... ", state.RoleID.ValueString(), state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(state.Project.ValueString() + ":" + state.RoleID.ValueString())
+
+	tflog.Trace(ctx, "Added actors to project role", map[string]interface{}{"role_id": state.RoleID.ValueString(), "project": state.Project.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectRoleActorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state, priorState JiraProjectRoleActorResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+
+	var accountIDs, priorAccountIDs, groupIDs, priorGroupIDs []string
+	resp.Diagnostics.Append(stringSetElements(ctx, state.AccountIDs, &accountIDs)...)
+	resp.Diagnostics.Append(stringSetElements(ctx, priorState.AccountIDs, &priorAccountIDs)...)
+	resp.Diagnostics.Append(stringSetElements(ctx, state.GroupIDs, &groupIDs)...)
+	resp.Diagnostics.Append(stringSetElements(ctx, priorState.GroupIDs, &priorGroupIDs)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Actors are added first, so that the role is never left without the actors it should end up with.
+	options := projectRoleActorsAddOptions{
+		User:    stringsMissingFrom(accountIDs, priorAccountIDs),
+		GroupID: stringsMissingFrom(groupIDs, priorGroupIDs),
+	}
+	if len(options.User) > 0 || len(options.GroupID) > 0 {
+		err := r.addActors(ctx, &state, options)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to add role actors",
+				fmt.Sprintf("An unexpected error occurred while adding actors to the role %s of the %s project... ", state.RoleID.ValueString(), state.Project.ValueString())+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+	}
+
+	for _, accountID := range stringsMissingFrom(priorAccountIDs, accountIDs) {
+		resp.Diagnostics.Append(r.removeActor(ctx, &state, "user", accountID)...)
+	}
+	for _, groupID := range stringsMissingFrom(priorGroupIDs, groupIDs) {
+		resp.Diagnostics.Append(r.removeActor(ctx, &state, "groupId", groupID)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updated actors of project role", map[string]interface{}{"role_id": state.RoleID.ValueString(), "project": state.Project.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectRoleActorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectRoleActorResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actualAccountIDs, actualGroupIDs, response, err := r.roleActors(ctx, &state)
+	if err != nil {
+		// The project or the role was deleted outside of Terraform.
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read role actors",
+			fmt.Sprintf("An unexpected error occurred while reading the actors of the role %s of the %s project... ", state.RoleID.ValueString(), state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// Only the managed actors that are still in the role are kept, so that the removed ones show up as drift.
+	resp.Diagnostics.Append(keepStringSetElements(ctx, &state.AccountIDs, actualAccountIDs)...)
+	resp.Diagnostics.Append(keepStringSetElements(ctx, &state.GroupIDs, actualGroupIDs)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectRoleActorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectRoleActorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	var accountIDs, groupIDs []string
+	resp.Diagnostics.Append(stringSetElements(ctx, state.AccountIDs, &accountIDs)...)
+	resp.Diagnostics.Append(stringSetElements(ctx, state.GroupIDs, &groupIDs)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, accountID := range accountIDs {
+		resp.Diagnostics.Append(r.removeActor(ctx, &state, "user", accountID)...)
+	}
+	for _, groupID := range groupIDs {
+		resp.Diagnostics.Append(r.removeActor(ctx, &state, "groupId", groupID)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Removed actors from project role", map[string]interface{}{"role_id": state.RoleID.ValueString(), "project": state.Project.ValueString()})
+}
+
+// addActors adds the users and groups of the options to the role.
+// Jira refuses to add an actor that is already in the role, so only the missing actors are sent.
+func (r *ProjectRoleActorResource) addActors(ctx context.Context, state *JiraProjectRoleActorResourceModel, options projectRoleActorsAddOptions) error {
+	actualAccountIDs, actualGroupIDs, _, err := r.roleActors(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	options = projectRoleActorsAddOptions{
+		User:    stringsNotIn(options.User, actualAccountIDs),
+		GroupID: stringsNotIn(options.GroupID, actualGroupIDs),
+	}
+	if len(options.User) == 0 && len(options.GroupID) == 0 {
+		return nil
+	}
+
+	_, err = doJiraRequest(ctx, r.client, http.MethodPost, projectRoleEndpoint(state), options, nil)
+	return err
+}
+
+// roleActors returns the account IDs of the users and the IDs of the groups acting in the role.
+func (r *ProjectRoleActorResource) roleActors(ctx context.Context, state *JiraProjectRoleActorResourceModel) (map[string]bool, map[string]bool, *jira.Response, error) {
+	role := new(projectRoleDetails)
+	response, err := doJiraRequest(ctx, r.client, http.MethodGet, projectRoleEndpoint(state), nil, role)
+	if err != nil {
+		return nil, nil, response, err
+	}
+
+	accountIDs := map[string]bool{}
+	groupIDs := map[string]bool{}
+	for _, actor := range role.Actors {
+		switch actor.Type {
+		case userRoleActorType:
+			accountIDs[actor.ActorUser.AccountID] = true
+		case groupRoleActorType:
+			groupIDs[actor.ActorGroup.GroupID] = true
+		}
+	}
+
+	return accountIDs, groupIDs, response, nil
+}
+
+// removeActor removes a single user or group from the role, the kind of actor being given by the query parameter.
+// An actor that already left the role is not an error.
+func (r *ProjectRoleActorResource) removeActor(ctx context.Context, state *JiraProjectRoleActorResourceModel, parameter, actor string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiEndpoint := projectRoleEndpoint(state) + "?" + url.Values{parameter: {actor}}.Encode()
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, apiEndpoint, nil, nil)
+	if err != nil && !isNotFound(response) {
+		diags.AddError(
+			"Failed to remove role actor",
+			fmt.Sprintf("An unexpected error occurred while removing %s from the role %s of the %s project... ", actor, state.RoleID.ValueString(), state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+	}
+
+	return diags
+}
+
+// projectRoleEndpoint returns the API endpoint of the role of the resource.
+func projectRoleEndpoint(state *JiraProjectRoleActorResourceModel) string {
+	return fmt.Sprintf("rest/api/3/project/%s/role/%s", url.PathEscape(state.Project.ValueString()), url.PathEscape(state.RoleID.ValueString()))
+}
+
+// stringSetElements appends the elements of a set of strings to target. A null set has no elements.
+func stringSetElements(ctx context.Context, set types.Set, target *[]string) diag.Diagnostics {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	return set.ElementsAs(ctx, target, false)
+}
+
+// keepStringSetElements removes the elements of a set of strings that are not in keep. A null set stays null.
+func keepStringSetElements(ctx context.Context, set *types.Set, keep map[string]bool) diag.Diagnostics {
+	var elements []string
+	diags := stringSetElements(ctx, *set, &elements)
+	if diags.HasError() || set.IsNull() {
+		return diags
+	}
+
+	kept := make([]string, 0, len(elements))
+	for _, element := range elements {
+		if keep[element] {
+			kept = append(kept, element)
+		}
+	}
+
+	*set, diags = types.SetValueFrom(ctx, types.StringType, kept)
+	return diags
+}
+
+// stringsMissingFrom returns the strings of values that are not in other.
+func stringsMissingFrom(values, other []string) []string {
+	present := make(map[string]bool, len(other))
+	for _, value := range other {
+		present[value] = true
+	}
+
+	return stringsNotIn(values, present)
+}
+
+// stringsNotIn returns the strings of values that are not in present.
+func stringsNotIn(values []string, present map[string]bool) []string {
+	var missing []string
+	for _, value := range values {
+		if !present[value] {
+			missing = append(missing, value)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringSet builds a set of strings for a test.
+func stringSet(values ...string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestProjectRoleActorResource(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewProjectRoleActorResource(), providerData)
+	jane := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+	john := fake.addUser("557058:john", "john@example.com", "John Doe")
+	alice := fake.addUser("557058:alice", "alice@example.com", "Alice Doe")
+	backend := fake.addGroup("backend")
+
+	const roleID = "10101"
+	actors := func() []string {
+		ids := fake.projectRoleActors(projectKey, roleID)
+		sort.Strings(ids)
+		return ids
+	}
+
+	// Jane already acts in the role, which Jira refuses to add her to again, and Alice isn't managed by Terraform.
+	fake.addRoleActor(projectKey, roleID, userRoleActorType, jane.AccountID)
+	fake.addRoleActor(projectKey, roleID, userRoleActorType, alice.AccountID)
+
+	// Create
+	state := h.create(JiraProjectRoleActorResourceModel{
+		ID:         types.StringUnknown(),
+		Project:    types.StringValue(projectKey),
+		RoleID:     types.StringValue(roleID),
+		AccountIDs: stringSet(jane.AccountID, john.AccountID),
+		GroupIDs:   stringSet(backend.ID),
+	})
+	if want := []string{alice.AccountID, jane.AccountID, john.AccountID, backend.ID}; !reflect.DeepEqual(actors(), want) {
+		t.Errorf("expected the actors %v after the creation, got: %v", want, actors())
+	}
+	if calls := fake.callCount(http.MethodPost, "/rest/api/3/project/"+projectKey+"/role/"+roleID); calls != 1 {
+		t.Errorf("expected a single request adding the missing actors, got: %d", calls)
+	}
+
+	var created JiraProjectRoleActorResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() != projectKey+":"+roleID {
+		t.Errorf("unexpected ID: %s", created.ID)
+	}
+
+	// Update
+	plan := created
+	plan.AccountIDs = stringSet(jane.AccountID)
+	state = h.update(state, plan)
+	if want := []string{alice.AccountID, jane.AccountID, backend.ID}; !reflect.DeepEqual(actors(), want) {
+		t.Errorf("expected the actors %v after the update, got: %v", want, actors())
+	}
+
+	// Read: an actor removed outside of Terraform shows up as drift, and is added again by the next apply.
+	fake.removeRoleActor(projectKey, roleID, jane.AccountID)
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the role actors to be found")
+	}
+
+	var read JiraProjectRoleActorResourceModel
+	h.get(state, &read)
+	if !read.AccountIDs.Equal(stringSet()) || !read.GroupIDs.Equal(stringSet(backend.ID)) {
+		t.Errorf("expected read to drop the removed actor, got: %s and %s", read.AccountIDs, read.GroupIDs)
+	}
+
+	state = h.update(state, plan)
+	if want := []string{alice.AccountID, jane.AccountID, backend.ID}; !reflect.DeepEqual(actors(), want) {
+		t.Errorf("expected the actors %v after adding back the removed actor, got: %v", want, actors())
+	}
+
+	// Delete only removes the managed actors.
+	h.delete(state)
+	if want := []string{alice.AccountID}; !reflect.DeepEqual(actors(), want) {
+		t.Errorf("expected the actors %v after the deletion, got: %v", want, actors())
+	}
+}
+
+func TestProjectRoleActorResource_Validate(t *testing.T) {
+	providerData, _, projectKey := testJira(t)
+	h := newResourceHarness(t, NewProjectRoleActorResource(), providerData)
+
+	diags := h.validate(JiraProjectRoleActorResourceModel{
+		ID:         types.StringNull(),
+		Project:    types.StringValue(projectKey),
+		RoleID:     types.StringValue("10101"),
+		AccountIDs: types.SetNull(types.StringType),
+		GroupIDs:   types.SetNull(types.StringType),
+	})
+	if !diags.HasError() || diags[0].Summary() != "Missing role actors" {
+		t.Errorf("expected a role without actors to be refused, got: %v", diags)
+	}
+}
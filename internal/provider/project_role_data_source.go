@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraProjectRoleDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraProjectRoleDataSource{}
+)
+
+func NewJiraProjectRoleDataSource() datasource.DataSource {
+	return &JiraProjectRoleDataSource{}
+}
+
+// JiraProjectRoleDataSource defines the data source implementation.
+type JiraProjectRoleDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+func (d *JiraProjectRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraProjectRoleDataSourceModel struct {
+	Project types.String `tfsdk:"project"`
+	Name    types.String `tfsdk:"name"`
+	ID      types.String `tfsdk:"id"`
+}
+
+func (d *JiraProjectRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *JiraProjectRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a role of a Jira project by name, e.g. to manage its actors with `jiracloud_project_role_actor`.",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira project.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role, e.g. `Administrators`, ignoring case.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the role.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JiraProjectRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectRoleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The endpoint maps the name of every role of the project to the URL of the role, which ends with its ID.
+	var roles map[string]string
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/role", url.PathEscape(state.Project.ValueString()))
+	_, err := doJiraRequest(ctx, d.client, http.MethodGet, apiEndpoint, nil, &roles)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read project roles",
+			fmt.Sprintf("An unexpected error occurred while reading the roles of the %s project... ", state.Project.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	names := make([]string, 0, len(roles))
+	for name, roleURL := range roles {
+		if strings.EqualFold(name, state.Name.ValueString()) {
+			state.ID = types.StringValue(path.Base(roleURL))
+
+			// Save data into Terraform state
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	resp.Diagnostics.AddAttributeError(
+		tfpath.Root("name"),
+		"Failed to find role",
+		fmt.Sprintf("The %s project has no role named %s. The roles of the project are: %s",
+			state.Project.ValueString(), state.Name.ValueString(), strings.Join(names, ", ")),
+	)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectRoleDataSource(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newDataSourceHarness(t, NewJiraProjectRoleDataSource(), providerData)
+
+	lookup := func(name string) JiraProjectRoleDataSourceModel {
+		return JiraProjectRoleDataSourceModel{
+			Project: types.StringValue(projectKey),
+			Name:    types.StringValue(name),
+			ID:      types.StringNull(),
+		}
+	}
+
+	// The name is matched ignoring case, and the ID is taken from the URL of the role.
+	var role JiraProjectRoleDataSourceModel
+	h.read(lookup("developers"), &role)
+	if role.ID.ValueString() != "10101" {
+		t.Errorf("expected the role ID 10101, got: %s", role.ID)
+	}
+
+	diags := h.tryRead(lookup("Reviewers"), &role)
+	if !diags.HasError() || diags[0].Summary() != "Failed to find role" {
+		t.Fatalf("expected an unknown role not to be found, got: %v", diags)
+	}
+	if want := "The roles of the project are: Administrators, Developers"; !strings.Contains(diags[0].Detail(), want) {
+		t.Errorf("expected the error to list the roles of the project, got: %s", diags[0].Detail())
+	}
+}
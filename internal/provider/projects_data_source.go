@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectsPageSize is the number of projects requested per page.
+const projectsPageSize = 50
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JiraProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &JiraProjectsDataSource{}
+)
+
+func NewJiraProjectsDataSource() datasource.DataSource {
+	return &JiraProjectsDataSource{}
+}
+
+// JiraProjectsDataSource defines the data source implementation.
+type JiraProjectsDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// projectsPage is a single page of the project search endpoint.
+type projectsPage struct {
+	IsLast bool             `json:"isLast"`
+	Values []projectDetails `json:"values"`
+}
+
+func (d *JiraProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraProjectsDataSourceModel struct {
+	TypeKey  types.String              `tfsdk:"type_key"`
+	Query    types.String              `tfsdk:"query"`
+	Count    types.Int64               `tfsdk:"count"`
+	Projects []JiraProjectSummaryModel `tfsdk:"projects"`
+}
+
+type JiraProjectSummaryModel struct {
+	ID             types.String `tfsdk:"id"`
+	Key            types.String `tfsdk:"key"`
+	Name           types.String `tfsdk:"name"`
+	ProjectTypeKey types.String `tfsdk:"project_type_key"`
+	LeadAccountID  types.String `tfsdk:"lead_account_id"`
+}
+
+func (d *JiraProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *JiraProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the Jira projects visible to the provider user, optionally filtered by type or name.",
+
+		Attributes: map[string]schema.Attribute{
+			"type_key": schema.StringAttribute{
+				MarkdownDescription: "Only list projects of this type, e.g. `software`, `service_desk` or `business`.",
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only list projects whose key or name contains this string, ignoring case.",
+				Optional:            true,
+			},
+			"count": schema.Int64Attribute{
+				MarkdownDescription: "The number of projects found.",
+				Computed:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The projects found.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"project_type_key": schema.StringAttribute{
+							MarkdownDescription: "The type of the project.",
+							Computed:            true,
+						},
+						"lead_account_id": schema.StringAttribute{
+							MarkdownDescription: "The Jira account ID of the project lead.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JiraProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraProjectsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("expand", "lead")
+	query.Set("maxResults", strconv.Itoa(projectsPageSize))
+	if !state.TypeKey.IsNull() {
+		query.Set("typeKey", state.TypeKey.ValueString())
+	}
+	if !state.Query.IsNull() {
+		query.Set("query", state.Query.ValueString())
+	}
+
+	state.Projects = []JiraProjectSummaryModel{}
+	for startAt := 0; ; startAt += projectsPageSize {
+		query.Set("startAt", strconv.Itoa(startAt))
+
+		page := new(projectsPage)
+		_, err := doJiraRequest(ctx, d.client, http.MethodGet, "rest/api/3/project/search?"+query.Encode(), nil, page)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to search projects",
+				"An unexpected error occurred while searching the projects... "+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+
+		for _, project := range page.Values {
+			state.Projects = append(state.Projects, JiraProjectSummaryModel{
+				ID:             types.StringValue(project.ID),
+				Key:            types.StringValue(project.Key),
+				Name:           types.StringValue(project.Name),
+				ProjectTypeKey: types.StringValue(project.ProjectTypeKey),
+				LeadAccountID:  types.StringValue(project.Lead.AccountID),
+			})
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	state.Count = types.Int64Value(int64(len(state.Projects)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -2,17 +2,28 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
+	"time"
 
 	jira "github.com/andygrunwald/go-jira/v2/cloud"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	authMethodBasic  = "basic"
+	authMethodBearer = "bearer"
 )
 
 // Ensure JiraCloudProvider satisfies various provider interfaces.
@@ -29,9 +40,29 @@ type JiraCloudProvider struct {
 
 // JiraCloudProviderModel describes the provider data model.
 type JiraCloudProviderModel struct {
-	Host      types.String `tfsdk:"host"`
-	UserEmail types.String `tfsdk:"user_email"`
-	ApiToken  types.String `tfsdk:"api_token"`
+	Host               types.String `tfsdk:"host"`
+	UserEmail          types.String `tfsdk:"user_email"`
+	ApiToken           types.String `tfsdk:"api_token"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryOnStatus      types.List   `tfsdk:"retry_on_status"`
+	AuthMethod         types.String `tfsdk:"auth_method"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	AllowInsecure      types.Bool   `tfsdk:"allow_insecure"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// JiraCloudProviderData is handed to the resources and data sources once the provider is configured.
+type JiraCloudProviderData struct {
+	Client *jira.Client
+	// RequestTimeout bounds every operation of a resource or data source, zero meaning no timeout.
+	RequestTimeout time.Duration
+	// Projects caches the project keys and IDs resolved during the operation.
+	Projects *projectCache
+	// Priorities caches the priorities of the instance during the operation.
+	Priorities *priorityCache
 }
 
 func (p *JiraCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -43,19 +74,78 @@ func (p *JiraCloudProvider) Schema(ctx context.Context, req provider.SchemaReque
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				MarkdownDescription: "The hostname of the Jira Cloud instance, e.g. `https://example.atlassian.net`.",
-				Optional:            true,
+				MarkdownDescription: "The hostname of the Jira Cloud instance, e.g. `https://example.atlassian.net`. " +
+					"The `https://` scheme is assumed when the hostname has none.",
+				Optional: true,
 			},
 			"user_email": schema.StringAttribute{
-				MarkdownDescription: "The user's email to authenticate with.",
+				MarkdownDescription: "The user's email to authenticate with. Required by the `basic` authentication method.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "The Jira Cloud API token to authenticate with.",
+				MarkdownDescription: "The Jira Cloud API token to authenticate with. Required by the `basic` authentication method.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"allow_insecure": schema.BoolAttribute{
+				MarkdownDescription: "Whether to accept a `host` using plain `http://`, e.g. for a local test instance. " +
+					"The credentials are then sent unencrypted. Defaults to `false`.",
+				Optional: true,
+			},
+			"auth_method": schema.StringAttribute{
+				MarkdownDescription: "How to authenticate with Jira Cloud: `basic` with `user_email` and `api_token`, " +
+					"or `bearer` with `access_token`. Defaults to `basic`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(authMethodBasic, authMethodBearer),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The token to send as `Authorization: Bearer` header, e.g. a scoped API token or " +
+					"the token of a gateway in front of Jira. Required by the `bearer` authentication method.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of seconds a single operation of a resource or data source may take, " +
+					"API calls included. Defaults to no timeout.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The number of times a request is retried when Jira answers with a transient failure, see `retry_on_status`. " +
+					"The `Retry-After` header sent by Jira is honored up to 30 seconds, otherwise the retries back off exponentially. Defaults to `3`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_on_status": schema.ListAttribute{
+				MarkdownDescription: "The HTTP status codes that make a request be retried. Defaults to `429` and the `5xx` server errors. " +
+					"`400` and `404` are never retried, and `POST` requests, which create objects, are only retried on `429`. " +
+					"Regardless of this setting, a `401` or `403` that Jira flags as transient, " +
+					"e.g. while a token is refreshed, is retried once after a short delay.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy to send the requests through, e.g. `http://proxy.example.com:3128`. " +
+					"Defaults to the proxy set by the `HTTPS_PROXY` and `NO_PROXY` environment variables, if any.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM bundle of CA certificates to trust on top of the system ones, " +
+					"e.g. for a TLS intercepting gateway.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the verification of the TLS certificate of Jira or the proxy. " +
+					"Only meant for testing, as it makes the connection vulnerable to interception. Defaults to `false`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -101,6 +191,14 @@ func (p *JiraCloudProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown Jira Cloud access token",
+			"The provider cannot create the Jira Cloud API client without a JIRA Cloud access token",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -108,6 +206,8 @@ func (p *JiraCloudProvider) Configure(ctx context.Context, req provider.Configur
 	host := os.Getenv("JIRA_URL")
 	userEmail := os.Getenv("JIRA_USER_EMAIL")
 	apiToken := os.Getenv("JIRA_TOKEN")
+	accessToken := os.Getenv("JIRA_ACCESS_TOKEN")
+	authMethod := authMethodBasic
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -121,44 +221,110 @@ func (p *JiraCloudProvider) Configure(ctx context.Context, req provider.Configur
 		apiToken = config.ApiToken.ValueString()
 	}
 
+	if !config.AccessToken.IsNull() {
+		accessToken = config.AccessToken.ValueString()
+	}
+
+	if !config.AuthMethod.IsNull() {
+		authMethod = config.AuthMethod.ValueString()
+	}
+
 	// Check if the values are set
 	if host == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing Jira Cloud host",
-			"The provider cannot create the Jira Cloud API client without a JIRA Cloud host url."+
+			"The provider cannot create the Jira Cloud API client without a JIRA Cloud host url. "+
 				"Set the `host` attribute in the provider configuration or set the `JIRA_URL` environment variable.",
 		)
+	} else {
+		normalizedHost, err := normalizeHost(host, config.AllowInsecure.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Invalid Jira Cloud host",
+				"The provider cannot create the Jira Cloud API client with the configured host: "+err.Error(),
+			)
+		}
+		host = normalizedHost
 	}
 
-	if apiToken == "" {
+	if authMethod == authMethodBasic && apiToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
 			"Missing Jira Cloud API token",
-			"The provider cannot create the Jira Cloud API client without a JIRA Cloud API token"+
-				"Set the `api_token` attribute in the provider configuration or set the `JIRA_TOKEN` environment variable.",
+			"The provider cannot create the Jira Cloud API client without a JIRA Cloud API token. "+
+				"Set the `api_token` attribute in the provider configuration or set the `JIRA_TOKEN` environment variable, "+
+				"or use the `bearer` authentication method.",
 		)
 	}
 
-	if userEmail == "" {
+	if authMethod == authMethodBasic && userEmail == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("user_email"),
 			"Missing Jira Cloud user email",
-			"The provider cannot create the Jira Cloud API client without a JIRA Cloud user email"+
-				"Set the `user_email` attribute in the provider configuration or set the `JIRA_USER_EMAIL` environment variable.",
+			"The provider cannot create the Jira Cloud API client without a JIRA Cloud user email. "+
+				"Set the `user_email` attribute in the provider configuration or set the `JIRA_USER_EMAIL` environment variable, "+
+				"or use the `bearer` authentication method.",
 		)
 	}
 
+	if authMethod == authMethodBearer && accessToken == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Missing Jira Cloud access token",
+			"The `bearer` authentication method requires an access token. "+
+				"Set the `access_token` attribute in the provider configuration or set the `JIRA_ACCESS_TOKEN` environment variable.",
+		)
+	}
+
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	var retryOnStatus []int
+	if !config.RetryOnStatus.IsNull() {
+		var statuses []int64
+		resp.Diagnostics.Append(config.RetryOnStatus.ElementsAs(ctx, &statuses, false)...)
+
+		retryOnStatus = make([]int, 0, len(statuses))
+		for _, status := range statuses {
+			retryOnStatus = append(retryOnStatus, int(status))
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Configure the Jira Cloud API client
-	transport := jira.BasicAuthTransport{
-		Username: userEmail,
-		APIToken: apiToken,
+	baseTransport, err := newHTTPTransport(config.ProxyURL.ValueString(), config.CACertFile.ValueString(), config.InsecureSkipVerify.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Jira Cloud connection settings",
+			"The provider cannot create the Jira Cloud API client with the configured `proxy_url` and `ca_cert_file`: "+err.Error(),
+		)
+		return
+	}
+
+	retryingTransport := newRetryTransport(newLoggingTransport(baseTransport, host), maxRetries, retryOnStatus)
+
+	var httpClient *http.Client
+	if authMethod == authMethodBearer {
+		transport := bearerAuthTransport{
+			Token:     accessToken,
+			Transport: retryingTransport,
+		}
+		httpClient = transport.Client()
+	} else {
+		transport := jira.BasicAuthTransport{
+			Username:  userEmail,
+			APIToken:  apiToken,
+			Transport: retryingTransport,
+		}
+		httpClient = transport.Client()
 	}
-	httpClient := transport.Client()
 
 	jiraClient, err := jira.NewClient(host, httpClient)
 	if err != nil {
@@ -170,24 +336,66 @@ func (p *JiraCloudProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	resp.DataSourceData = jiraClient
-	resp.ResourceData = jiraClient
+	providerData := &JiraCloudProviderData{
+		Client:         jiraClient,
+		RequestTimeout: time.Duration(config.RequestTimeout.ValueInt64()) * time.Second,
+		Projects:       newProjectCache(),
+		Priorities:     newPriorityCache(),
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+
+	tflog.Debug(ctx, "Configured Jira Cloud client", map[string]interface{}{
+		"jira_host":       host,
+		"auth_method":     authMethod,
+		"max_retries":     maxRetries,
+		"request_timeout": providerData.RequestTimeout.String(),
+	})
 }
 
 func (p *JiraCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewComponentResource,
+		NewBoardEstimationResource,
+		NewIssueTypeScreenSchemeProjectResource,
+		NewProjectResource,
+		NewVersionResource,
+		NewGroupResource,
+		NewGroupMembershipResource,
+		NewIssueRankResource,
+		NewIssueResource,
+		NewProjectRoleActorResource,
+		NewDashboardResource,
+		NewFilterResource,
+		NewWebhookResource,
+		NewProjectCategoryResource,
 	}
 }
 
 func (p *JiraCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewJiraBoardDataSource,
 		NewJiraComponentDataSource,
+		NewJiraComponentsDataSource,
+		NewJiraIssueTypeDataSource,
+		NewJiraLabelsDataSource,
+		NewJiraNotificationEventsDataSource,
+		NewJiraPrioritiesDataSource,
+		NewJiraProjectCategoryDataSource,
+		NewJiraProjectRoleDataSource,
+		NewJiraProjectsDataSource,
+		NewJiraSprintDataSource,
+		NewJiraUserDataSource,
 	}
 }
 
 func (p *JiraCloudProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewIssueURLFunction,
+		NewIsValidProjectKeyFunction,
+		NewNormalizeProjectKeyFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
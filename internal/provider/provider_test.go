@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testAccPreCheck fails the test when the environment variables needed to reach a real Jira instance are missing.
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+
+	for _, name := range []string{"JIRA_URL", "JIRA_USER_EMAIL", "JIRA_TOKEN", "JIRA_TEST_PROJECT"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
+
+// testJira configures the provider for a test and returns the data handed to the resources and data sources,
+// along with the key of the project the test works in.
+//
+// By default the provider talks to a fake Jira holding a single `TEST` project, which is returned so that
+// the test can program it. When TF_ACC is set, the provider talks to the Jira instance set by the JIRA_*
+// environment variables instead, the project is the one named by JIRA_TEST_PROJECT, and the fake returned is nil.
+func testJira(t *testing.T) (*JiraCloudProviderData, *fakeJira, string) {
+	t.Helper()
+
+	config := JiraCloudProviderModel{
+		RetryOnStatus: types.ListNull(types.Int64Type),
+		MaxRetries:    types.Int64Value(0),
+	}
+
+	var fake *fakeJira
+	projectKey := os.Getenv("JIRA_TEST_PROJECT")
+	if os.Getenv("TF_ACC") != "" {
+		testAccPreCheck(t)
+	} else {
+		fake = newFakeJira(t)
+		projectKey = fake.addProject("TEST", "Test").Key
+
+		config.Host = types.StringValue(fake.URL)
+		config.AllowInsecure = types.BoolValue(true)
+		config.UserEmail = types.StringValue("terraform@example.com")
+		config.ApiToken = types.StringValue("token")
+	}
+
+	return configureTestProvider(t, config), fake, projectKey
+}
+
+// configureTestProvider configures the provider with the given configuration, and returns the provider data.
+func configureTestProvider(t *testing.T, config JiraCloudProviderModel) *JiraCloudProviderData {
+	t.Helper()
+
+	providerData, diags := tryConfigureTestProvider(t, config)
+	if diags.HasError() {
+		t.Fatalf("configuring the provider: %v", diags)
+	}
+
+	return providerData
+}
+
+// tryConfigureTestProvider configures the provider with the given configuration, and returns the provider data
+// unless the configuration fails.
+func tryConfigureTestProvider(t *testing.T, config JiraCloudProviderModel) (*JiraCloudProviderData, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	// tfsdk.Config can't be built from a model, but tfsdk.State can and shares the same representation.
+	raw := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := raw.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("setting the provider configuration: %v", diags)
+	}
+
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	providerData, ok := resp.ResourceData.(*JiraCloudProviderData)
+	if !ok {
+		t.Fatalf("expected *JiraCloudProviderData, got: %T", resp.ResourceData)
+	}
+
+	return providerData, resp.Diagnostics
+}
@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// resourceHarness drives a resource through its lifecycle in-process, the way Terraform would over the plugin protocol.
+// Plans and states are given as the model structs of the resource, with the computed attributes Terraform doesn't know yet
+// set to unknown.
+type resourceHarness struct {
+	t        *testing.T
+	ctx      context.Context
+	resource resource.Resource
+	schema   schema.Schema
+}
+
+// newResourceHarness returns a harness for the resource, configured with the given provider data.
+func newResourceHarness(t *testing.T, r resource.Resource, providerData *JiraCloudProviderData) *resourceHarness {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("getting the schema: %v", schemaResp.Diagnostics)
+	}
+
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		resp := resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("configuring the resource: %v", resp.Diagnostics)
+		}
+	}
+
+	return &resourceHarness{t: t, ctx: ctx, resource: r, schema: schemaResp.Schema}
+}
+
+// raw converts a model into the value Terraform would send for it.
+func (h *resourceHarness) raw(model interface{}) tftypes.Value {
+	h.t.Helper()
+
+	state := tfsdk.State{Schema: h.schema}
+	if diags := state.Set(h.ctx, model); diags.HasError() {
+		h.t.Fatalf("converting %T: %v", model, diags)
+	}
+
+	return state.Raw
+}
+
+// null returns the empty state Terraform starts a create or an import with.
+func (h *resourceHarness) null() tfsdk.State {
+	return tfsdk.State{Schema: h.schema, Raw: tftypes.NewValue(h.schema.Type().TerraformType(h.ctx), nil)}
+}
+
+// validate runs the validators of the top-level attributes and the config validation of the resource, if it has one.
+func (h *resourceHarness) validate(config interface{}) diag.Diagnostics {
+	h.t.Helper()
+
+	tfConfig := tfsdk.Config{Schema: h.schema, Raw: h.raw(config)}
+
+	var diags diag.Diagnostics
+	for name, attribute := range h.schema.Attributes {
+		diags.Append(validateAttribute(h.ctx, tfConfig, name, attribute)...)
+	}
+
+	validator, ok := h.resource.(resource.ResourceWithValidateConfig)
+	if !ok {
+		return diags
+	}
+
+	resp := resource.ValidateConfigResponse{}
+	validator.ValidateConfig(h.ctx, resource.ValidateConfigRequest{Config: tfConfig}, &resp)
+	diags.Append(resp.Diagnostics...)
+
+	return diags
+}
+
+// tryCreate creates the resource from the given plan.
+func (h *resourceHarness) tryCreate(plan interface{}) (tfsdk.State, diag.Diagnostics) {
+	h.t.Helper()
+
+	raw := h.raw(plan)
+	resp := resource.CreateResponse{State: h.null()}
+	h.resource.Create(h.ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: h.schema, Raw: raw},
+		Plan:   tfsdk.Plan{Schema: h.schema, Raw: raw},
+	}, &resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// create creates the resource from the given plan, and fails the test on error.
+func (h *resourceHarness) create(plan interface{}) tfsdk.State {
+	h.t.Helper()
+
+	state, diags := h.tryCreate(plan)
+	if diags.HasError() {
+		h.t.Fatalf("create: %v", diags)
+	}
+
+	return state
+}
+
+// read refreshes the state, and reports whether the resource still exists.
+func (h *resourceHarness) read(state tfsdk.State) (tfsdk.State, bool) {
+	h.t.Helper()
+
+	resp := resource.ReadResponse{State: state}
+	h.resource.Read(h.ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		h.t.Fatalf("read: %v", resp.Diagnostics)
+	}
+
+	return resp.State, !resp.State.Raw.IsNull()
+}
+
+// modifyPlan runs the plan modifiers of the top-level attributes, then the plan modification of the resource, if it has one,
+// for an update from the prior state to the given plan. It returns the modified plan and the attributes that force the
+// resource to be replaced.
+func (h *resourceHarness) modifyPlan(prior tfsdk.State, plan interface{}) (tfsdk.Plan, path.Paths) {
+	h.t.Helper()
+
+	raw := h.raw(plan)
+
+	return h.modifyRawPlan(prior, h.configOf(raw), raw)
+}
+
+// modifyRawPlan runs the plan modifiers and the plan modification of the resource on a plan given as a raw value.
+func (h *resourceHarness) modifyRawPlan(prior tfsdk.State, rawConfig, rawPlan tftypes.Value) (tfsdk.Plan, path.Paths) {
+	h.t.Helper()
+
+	config := tfsdk.Config{Schema: h.schema, Raw: rawConfig}
+	modified := tfsdk.Plan{Schema: h.schema, Raw: rawPlan}
+
+	var requiresReplace path.Paths
+	for name, attribute := range h.schema.Attributes {
+		replace, diags := modifyAttributePlan(h.ctx, config, prior, &modified, name, attribute)
+		if diags.HasError() {
+			h.t.Fatalf("modify plan of %s: %v", name, diags)
+		}
+		if replace {
+			requiresReplace.Append(path.Root(name))
+		}
+	}
+
+	modifier, ok := h.resource.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return modified, requiresReplace
+	}
+
+	resp := resource.ModifyPlanResponse{Plan: modified, RequiresReplace: requiresReplace}
+	modifier.ModifyPlan(h.ctx, resource.ModifyPlanRequest{
+		Config: config,
+		Plan:   modified,
+		State:  prior,
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		h.t.Fatalf("modify plan: %v", resp.Diagnostics)
+	}
+
+	return resp.Plan, resp.RequiresReplace
+}
+
+// planUpdate plans an update from the prior state to the given configuration like Terraform does: computed attributes
+// that aren't configured keep their prior value, unless they have a default, before the plan is modified.
+// It returns the planned state and the attributes that force the resource to be replaced.
+func (h *resourceHarness) planUpdate(prior tfsdk.State, config interface{}) (tfsdk.Plan, path.Paths) {
+	h.t.Helper()
+
+	rawConfig := h.raw(config)
+
+	var configured, priorValues map[string]tftypes.Value
+	if err := rawConfig.As(&configured); err != nil {
+		h.t.Fatalf("reading the configuration: %v", err)
+	}
+	if err := prior.Raw.As(&priorValues); err != nil {
+		h.t.Fatalf("reading the prior state: %v", err)
+	}
+
+	proposed := make(map[string]tftypes.Value, len(configured))
+	for name, attribute := range h.schema.Attributes {
+		value := configured[name]
+		if value.IsNull() && attribute.IsComputed() {
+			value = priorValues[name]
+
+			var defaultValue attr.Value
+			switch attribute := attribute.(type) {
+			case schema.StringAttribute:
+				if attribute.Default != nil {
+					resp := defaults.StringResponse{}
+					attribute.Default.DefaultString(h.ctx, defaults.StringRequest{}, &resp)
+					defaultValue = resp.PlanValue
+				}
+			case schema.BoolAttribute:
+				if attribute.Default != nil {
+					resp := defaults.BoolResponse{}
+					attribute.Default.DefaultBool(h.ctx, defaults.BoolRequest{}, &resp)
+					defaultValue = resp.PlanValue
+				}
+			}
+			if defaultValue != nil {
+				var err error
+				if value, err = defaultValue.ToTerraformValue(h.ctx); err != nil {
+					h.t.Fatalf("defaulting %s: %v", name, err)
+				}
+			}
+		}
+		proposed[name] = value
+	}
+
+	return h.modifyRawPlan(prior, rawConfig, tftypes.NewValue(rawConfig.Type(), proposed))
+}
+
+// configOf returns the configuration the given plan was planned from, in which the attributes
+// that can only be computed are null, like Terraform sends them.
+func (h *resourceHarness) configOf(plan tftypes.Value) tftypes.Value {
+	h.t.Helper()
+
+	var planned map[string]tftypes.Value
+	if err := plan.As(&planned); err != nil {
+		h.t.Fatalf("reading the plan: %v", err)
+	}
+
+	values := make(map[string]tftypes.Value, len(planned))
+	for name, value := range planned {
+		if attribute := h.schema.Attributes[name]; attribute.IsComputed() && !attribute.IsOptional() {
+			value = tftypes.NewValue(value.Type(), nil)
+		}
+		values[name] = value
+	}
+
+	return tftypes.NewValue(plan.Type(), values)
+}
+
+// tryUpdate updates the resource from its prior state to the given plan.
+func (h *resourceHarness) tryUpdate(prior tfsdk.State, plan interface{}) (tfsdk.State, diag.Diagnostics) {
+	h.t.Helper()
+
+	raw := h.raw(plan)
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: h.schema, Raw: raw}}
+	h.resource.Update(h.ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: h.schema, Raw: raw},
+		Plan:   tfsdk.Plan{Schema: h.schema, Raw: raw},
+		State:  prior,
+	}, &resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// update updates the resource from its prior state to the given plan, and fails the test on error.
+func (h *resourceHarness) update(prior tfsdk.State, plan interface{}) tfsdk.State {
+	h.t.Helper()
+
+	state, diags := h.tryUpdate(prior, plan)
+	if diags.HasError() {
+		h.t.Fatalf("update: %v", diags)
+	}
+
+	return state
+}
+
+// delete deletes the resource, and fails the test on error.
+func (h *resourceHarness) delete(state tfsdk.State) {
+	h.t.Helper()
+
+	resp := resource.DeleteResponse{State: state}
+	h.resource.Delete(h.ctx, resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		h.t.Fatalf("delete: %v", resp.Diagnostics)
+	}
+}
+
+// importState imports the resource with the given ID and reads it, like `terraform import` does.
+func (h *resourceHarness) importState(id string) tfsdk.State {
+	h.t.Helper()
+
+	importer, ok := h.resource.(resource.ResourceWithImportState)
+	if !ok {
+		h.t.Fatalf("%T doesn't support import", h.resource)
+	}
+
+	resp := resource.ImportStateResponse{State: h.null()}
+	importer.ImportState(h.ctx, resource.ImportStateRequest{ID: id}, &resp)
+	if resp.Diagnostics.HasError() {
+		h.t.Fatalf("import %q: %v", id, resp.Diagnostics)
+	}
+
+	state, found := h.read(resp.State)
+	if !found {
+		h.t.Fatalf("import %q: the imported resource doesn't exist", id)
+	}
+
+	return state
+}
+
+// get converts the state into the model of the resource.
+func (h *resourceHarness) get(state tfsdk.State, model interface{}) {
+	h.t.Helper()
+
+	if diags := state.Get(h.ctx, model); diags.HasError() {
+		h.t.Fatalf("converting the state to %T: %v", model, diags)
+	}
+}
+
+// modifyAttributePlan runs the plan modifiers of a top-level string, bool, int64 or set attribute of a resource,
+// like Terraform does before calling the plan modification of the resource, and updates the plan with their result.
+// It reports whether the attribute forces the resource to be replaced.
+func modifyAttributePlan(ctx context.Context, config tfsdk.Config, state tfsdk.State, plan *tfsdk.Plan, name string, attribute schema.Attribute) (bool, diag.Diagnostics) {
+	attributePath := path.Root(name)
+
+	var diags diag.Diagnostics
+	requiresReplace := false
+	switch attribute := attribute.(type) {
+	case schema.StringAttribute:
+		var configValue, stateValue, planValue types.String
+		diags.Append(config.GetAttribute(ctx, attributePath, &configValue)...)
+		diags.Append(state.GetAttribute(ctx, attributePath, &stateValue)...)
+		diags.Append(plan.GetAttribute(ctx, attributePath, &planValue)...)
+		for _, modifier := range attribute.PlanModifiers {
+			resp := planmodifier.StringResponse{PlanValue: planValue}
+			modifier.PlanModifyString(ctx, planmodifier.StringRequest{
+				Path: attributePath, Config: config, ConfigValue: configValue, Plan: *plan, PlanValue: planValue, State: state, StateValue: stateValue,
+			}, &resp)
+			diags.Append(resp.Diagnostics...)
+			planValue, requiresReplace = resp.PlanValue, requiresReplace || resp.RequiresReplace
+		}
+		diags.Append(plan.SetAttribute(ctx, attributePath, planValue)...)
+	case schema.BoolAttribute:
+		var configValue, stateValue, planValue types.Bool
+		diags.Append(config.GetAttribute(ctx, attributePath, &configValue)...)
+		diags.Append(state.GetAttribute(ctx, attributePath, &stateValue)...)
+		diags.Append(plan.GetAttribute(ctx, attributePath, &planValue)...)
+		for _, modifier := range attribute.PlanModifiers {
+			resp := planmodifier.BoolResponse{PlanValue: planValue}
+			modifier.PlanModifyBool(ctx, planmodifier.BoolRequest{
+				Path: attributePath, Config: config, ConfigValue: configValue, Plan: *plan, PlanValue: planValue, State: state, StateValue: stateValue,
+			}, &resp)
+			diags.Append(resp.Diagnostics...)
+			planValue, requiresReplace = resp.PlanValue, requiresReplace || resp.RequiresReplace
+		}
+		diags.Append(plan.SetAttribute(ctx, attributePath, planValue)...)
+	case schema.Int64Attribute:
+		var configValue, stateValue, planValue types.Int64
+		diags.Append(config.GetAttribute(ctx, attributePath, &configValue)...)
+		diags.Append(state.GetAttribute(ctx, attributePath, &stateValue)...)
+		diags.Append(plan.GetAttribute(ctx, attributePath, &planValue)...)
+		for _, modifier := range attribute.PlanModifiers {
+			resp := planmodifier.Int64Response{PlanValue: planValue}
+			modifier.PlanModifyInt64(ctx, planmodifier.Int64Request{
+				Path: attributePath, Config: config, ConfigValue: configValue, Plan: *plan, PlanValue: planValue, State: state, StateValue: stateValue,
+			}, &resp)
+			diags.Append(resp.Diagnostics...)
+			planValue, requiresReplace = resp.PlanValue, requiresReplace || resp.RequiresReplace
+		}
+		diags.Append(plan.SetAttribute(ctx, attributePath, planValue)...)
+	case schema.SetAttribute:
+		var configValue, stateValue, planValue types.Set
+		diags.Append(config.GetAttribute(ctx, attributePath, &configValue)...)
+		diags.Append(state.GetAttribute(ctx, attributePath, &stateValue)...)
+		diags.Append(plan.GetAttribute(ctx, attributePath, &planValue)...)
+		for _, modifier := range attribute.PlanModifiers {
+			resp := planmodifier.SetResponse{PlanValue: planValue}
+			modifier.PlanModifySet(ctx, planmodifier.SetRequest{
+				Path: attributePath, Config: config, ConfigValue: configValue, Plan: *plan, PlanValue: planValue, State: state, StateValue: stateValue,
+			}, &resp)
+			diags.Append(resp.Diagnostics...)
+			planValue, requiresReplace = resp.PlanValue, requiresReplace || resp.RequiresReplace
+		}
+		diags.Append(plan.SetAttribute(ctx, attributePath, planValue)...)
+	}
+
+	return requiresReplace, diags
+}
+
+// validateAttribute runs the validators of a top-level attribute of a resource or data source schema against the config,
+// like Terraform does before calling the config validation. Validators of nested attributes are not run.
+func validateAttribute(ctx context.Context, config tfsdk.Config, name string, attribute interface{}) diag.Diagnostics {
+	attributePath := path.Root(name)
+
+	var diags diag.Diagnostics
+	switch attribute := attribute.(type) {
+	case interface{ StringValidators() []validator.String }:
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+		for _, v := range attribute.StringValidators() {
+			resp := validator.StringResponse{}
+			v.ValidateString(ctx, validator.StringRequest{Path: attributePath, PathExpression: attributePath.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case interface{ Int64Validators() []validator.Int64 }:
+		var value types.Int64
+		diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+		for _, v := range attribute.Int64Validators() {
+			resp := validator.Int64Response{}
+			v.ValidateInt64(ctx, validator.Int64Request{Path: attributePath, PathExpression: attributePath.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case interface{ SetValidators() []validator.Set }:
+		var value types.Set
+		diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+		for _, v := range attribute.SetValidators() {
+			resp := validator.SetResponse{}
+			v.ValidateSet(ctx, validator.SetRequest{Path: attributePath, PathExpression: attributePath.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case interface{ ListValidators() []validator.List }:
+		var value types.List
+		diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+		for _, v := range attribute.ListValidators() {
+			resp := validator.ListResponse{}
+			v.ValidateList(ctx, validator.ListRequest{Path: attributePath, PathExpression: attributePath.Expression(), Config: config, ConfigValue: value}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	}
+
+	return diags
+}
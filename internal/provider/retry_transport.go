@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultMaxRetries is the number of times a request is retried when the provider doesn't say otherwise.
+	defaultMaxRetries = 3
+
+	// retryBaseDelay is the delay before the first retry when Jira doesn't send a Retry-After header.
+	retryBaseDelay = time.Second
+
+	// retryMaxDelay caps the exponential backoff and the Retry-After delay between two retries.
+	retryMaxDelay = 30 * time.Second
+
+	// authRetryDelay is the delay before retrying a request whose authentication failed transiently.
+	authRetryDelay = 2 * time.Second
+
+	// seraphLoginReasonHeader is the header Jira explains authentication failures with.
+	seraphLoginReasonHeader = "X-Seraph-LoginReason"
+)
+
+// transientLoginReasons are the login reasons Jira sends along with authentication failures that may pass,
+// e.g. while a token is refreshed or the permission caches warm up. Denied logins, e.g. behind a CAPTCHA, are not.
+var transientLoginReasons = map[string]bool{
+	"AUTHENTICATED_FAILED": true,
+	"AUTHORISATION_FAILED": true,
+}
+
+// retryTransport retries the requests that Jira answers with a transient failure, see isRetryable,
+// or with one of the configured status codes. It honors the Retry-After header Jira Cloud sends along
+// with rate limited responses, and falls back to an exponential backoff with jitter otherwise.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	// retryOnStatus replaces isRetryable to decide which answers other than authentication failures are retried, if set.
+	retryOnStatus map[int]bool
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, retryOnStatus []int) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	var statuses map[int]bool
+	if retryOnStatus != nil {
+		statuses = make(map[int]bool, len(retryOnStatus))
+		for _, status := range retryOnStatus {
+			statuses[status] = true
+		}
+	}
+
+	return &retryTransport{
+		next:          next,
+		maxRetries:    maxRetries,
+		retryOnStatus: statuses,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	authRetried := false
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err != nil || attempt >= t.maxRetries || !t.shouldRetry(req.Method, resp, authRetried) {
+			return resp, err
+		}
+
+		// A request whose body can't be read again can't be retried.
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		if isAuthFailure(resp.StatusCode) {
+			delay = authRetryDelay
+			authRetried = true
+		}
+
+		tflog.Debug(req.Context(), "Retrying request to Jira", map[string]interface{}{
+			"http_method":  req.Method,
+			"api_endpoint": req.URL.Path,
+			"status_code":  resp.StatusCode,
+			"delay":        delay.String(),
+			"retry":        attempt + 1,
+			"max_retries":  t.maxRetries,
+		})
+
+		// Drain the body so that the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		attemptReq = req.Clone(req.Context())
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+	}
+}
+
+// shouldRetry reports whether the request with the given method that got the given response is retried.
+// Authentication failures are retried at most once, whatever the configured status codes.
+// Requests that aren't idempotent, e.g. the POSTs creating objects, are only retried when Jira rate limited them,
+// since a server error may come back after Jira created the object, and retrying would create it twice.
+func (t *retryTransport) shouldRetry(method string, resp *http.Response, authRetried bool) bool {
+	switch {
+	case isAuthFailure(resp.StatusCode):
+		return !authRetried && isRetryable(resp)
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound:
+		return false
+	case !isIdempotent(method) && resp.StatusCode != http.StatusTooManyRequests:
+		return false
+	case t.retryOnStatus != nil:
+		return t.retryOnStatus[resp.StatusCode]
+	default:
+		return isRetryable(resp)
+	}
+}
+
+// isRetryable reports whether a response of Jira is a transient failure that may pass when the request is sent again:
+// rate limiting, server errors, and authentication failures Jira flags as transient with its login reason header
+// or a Retry-After header. Any other answer, e.g. a 400 for an invalid request or a 404, is permanent.
+func isRetryable(resp *http.Response) bool {
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true
+	case isAuthFailure(resp.StatusCode):
+		return transientLoginReasons[resp.Header.Get(seraphLoginReasonHeader)] || resp.Header.Get("Retry-After") != ""
+	default:
+		return false
+	}
+}
+
+// isIdempotent reports whether sending a request with the given method twice has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isAuthFailure reports whether the status code is Jira refusing the credentials or their permissions.
+func isAuthFailure(statusCode int) bool {
+	return statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden
+}
+
+// retryDelay returns how long to wait before retrying the request that got the given response.
+// The Retry-After header, in seconds or as an HTTP date, takes precedence over the backoff,
+// but is capped at retryMaxDelay so that an unreasonable value doesn't stall the operation.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			if seconds > int(retryMaxDelay/time.Second) {
+				return retryMaxDelay
+			}
+			return time.Duration(seconds) * time.Second
+		}
+
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			delay := time.Until(date)
+			switch {
+			case delay <= 0:
+				return 0
+			case delay > retryMaxDelay:
+				return retryMaxDelay
+			default:
+				return delay
+			}
+		}
+	}
+
+	backoff := retryBaseDelay << attempt
+	if backoff <= 0 || backoff > retryMaxDelay {
+		backoff = retryMaxDelay
+	}
+
+	// Wait somewhere between half and the whole backoff, so that concurrent requests don't retry in lockstep.
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		header     http.Header
+		want       bool
+	}{
+		{"rate limited", http.StatusTooManyRequests, nil, true},
+		{"server error", http.StatusInternalServerError, nil, true},
+		{"service unavailable", http.StatusServiceUnavailable, nil, true},
+		{"bad request", http.StatusBadRequest, nil, false},
+		{"not found", http.StatusNotFound, nil, false},
+		{"conflict", http.StatusConflict, nil, false},
+		{"unauthorized", http.StatusUnauthorized, nil, false},
+		{"forbidden", http.StatusForbidden, nil, false},
+		{"unauthorized with a denied login", http.StatusUnauthorized, http.Header{"X-Seraph-Loginreason": {"AUTHENTICATION_DENIED"}}, false},
+		{"unauthorized with a failed login", http.StatusUnauthorized, http.Header{"X-Seraph-Loginreason": {"AUTHENTICATED_FAILED"}}, true},
+		{"forbidden with a failed authorisation", http.StatusForbidden, http.Header{"X-Seraph-Loginreason": {"AUTHORISATION_FAILED"}}, true},
+		{"forbidden with a retry after", http.StatusForbidden, http.Header{"Retry-After": {"1"}}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: test.statusCode, Header: test.header}
+			if resp.Header == nil {
+				resp.Header = http.Header{}
+			}
+
+			if got := isRetryable(resp); got != test.want {
+				t.Errorf("isRetryable() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		retryOnStatus []int
+		statusCode    int
+		header        http.Header
+		wantRequests  int32
+	}{
+		{"rate limited", http.MethodGet, nil, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}}, 3},
+		{"not found", http.MethodGet, nil, http.StatusNotFound, nil, 1},
+		{"bad request although configured", http.MethodGet, []int{http.StatusBadRequest}, http.StatusBadRequest, nil, 1},
+		{"configured status", http.MethodGet, []int{http.StatusConflict}, http.StatusConflict, http.Header{"Retry-After": {"0"}}, 3},
+		{"unconfigured status", http.MethodGet, []int{http.StatusConflict}, http.StatusTooManyRequests, nil, 1},
+		{"unauthorized", http.MethodGet, nil, http.StatusUnauthorized, nil, 1},
+		{"transiently unauthorized", http.MethodGet, []int{}, http.StatusUnauthorized, http.Header{"X-Seraph-Loginreason": {"AUTHENTICATED_FAILED"}}, 2},
+		{"server error of an update", http.MethodPut, nil, http.StatusInternalServerError, http.Header{"Retry-After": {"0"}}, 3},
+		{"server error of a deletion", http.MethodDelete, nil, http.StatusServiceUnavailable, http.Header{"Retry-After": {"0"}}, 3},
+		{"server error of a creation", http.MethodPost, nil, http.StatusInternalServerError, http.Header{"Retry-After": {"0"}}, 1},
+		{"configured status of a creation", http.MethodPost, []int{http.StatusConflict}, http.StatusConflict, http.Header{"Retry-After": {"0"}}, 1},
+		{"rate limited creation", http.MethodPost, nil, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}}, 3},
+		{"rate limited creation unconfigured", http.MethodPost, []int{http.StatusConflict}, http.StatusTooManyRequests, nil, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				for name, values := range test.header {
+					w.Header()[name] = values
+				}
+				w.WriteHeader(test.statusCode)
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(test.method, server.URL, nil)
+			if err != nil {
+				t.Fatalf("failed to build the request: %v", err)
+			}
+
+			client := &http.Client{Transport: newRetryTransport(nil, 2, test.retryOnStatus)}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != test.statusCode {
+				t.Errorf("expected the last answer %d, got: %d", test.statusCode, resp.StatusCode)
+			}
+			if requests != test.wantRequests {
+				t.Errorf("expected %d requests, got: %d", test.wantRequests, requests)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_RateLimitedThenSuccess(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"summary":"Release 1.0.0"}` {
+			t.Errorf("expected every attempt to send the whole body, got: %s", body)
+		}
+
+		// Jira rate limits the first two attempts.
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(nil, defaultMaxRetries, nil)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"summary":"Release 1.0.0"}`))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected the request to succeed after being rate limited, got: %d", resp.StatusCode)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got: %d", requests)
+	}
+}
+
+func TestRetryDelay_CapsRetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		want       time.Duration
+	}{
+		{"seconds", "3", 3 * time.Second},
+		{"too many seconds", "86400", retryMaxDelay},
+		{"past date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0},
+		{"far date", time.Now().Add(24 * time.Hour).UTC().Format(http.TimeFormat), retryMaxDelay},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {test.retryAfter}}}
+			if got := retryDelay(resp, 0); got != test.want {
+				t.Errorf("retryDelay() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// sharePermissionGlobal shares a dashboard or filter with anyone, including anonymous users.
+	sharePermissionGlobal = "global"
+	// sharePermissionAuthenticated shares a dashboard or filter with every user logged in to the instance.
+	sharePermissionAuthenticated = "authenticated"
+	// sharePermissionProject shares a dashboard or filter with the users browsing a project.
+	sharePermissionProject = "project"
+	// sharePermissionGroup shares a dashboard or filter with the members of a group.
+	sharePermissionGroup = "group"
+	// sharePermissionUser shares a dashboard or filter with a single user.
+	sharePermissionUser = "user"
+)
+
+// sharePermissionAttrTypes are the attribute types of the objects in a share_permissions set.
+var sharePermissionAttrTypes = map[string]attr.Type{
+	"type":      types.StringType,
+	"target_id": types.StringType,
+}
+
+// sharePermission is a share or edit permission of a dashboard or filter as exchanged with the Jira API.
+// Jira assigns an ID to each permission, which the provider never sends and ignores when reading.
+type sharePermission struct {
+	ID      int64                  `json:"id,omitempty"`
+	Type    string                 `json:"type"`
+	Project *sharePermissionTarget `json:"project,omitempty"`
+	Group   *sharePermissionTarget `json:"group,omitempty"`
+	User    *sharePermissionTarget `json:"user,omitempty"`
+}
+
+// sharePermissionTarget is the project, group or user a share permission is granted to.
+type sharePermissionTarget struct {
+	ID        string `json:"id,omitempty"`
+	GroupID   string `json:"groupId,omitempty"`
+	AccountID string `json:"accountId,omitempty"`
+}
+
+type JiraSharePermissionModel struct {
+	Type     types.String `tfsdk:"type"`
+	TargetID types.String `tfsdk:"target_id"`
+}
+
+// sharePermissionsAttribute returns the schema of the share_permissions attribute of a dashboard or filter,
+// with the given description.
+func sharePermissionsAttribute(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Who the permission is granted to: `global` for anyone, `authenticated` for every " +
+						"logged in user, or `project`, `group` or `user` for the one set by `target_id`.",
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							sharePermissionGlobal,
+							sharePermissionAuthenticated,
+							sharePermissionProject,
+							sharePermissionGroup,
+							sharePermissionUser,
+						),
+					},
+				},
+				"target_id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the project, the ID of the group, or the account ID of the user the permission " +
+						"is granted to. Required by the `project`, `group` and `user` types, and not allowed by the others.",
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// validateSharePermissions checks that the configured share permissions have a target exactly when their type needs one.
+func validateSharePermissions(ctx context.Context, sharePermissions types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if sharePermissions.IsNull() || sharePermissions.IsUnknown() {
+		return diags
+	}
+
+	var permissions []JiraSharePermissionModel
+	diags.Append(sharePermissions.ElementsAs(ctx, &permissions, false)...)
+
+	for _, permission := range permissions {
+		if permission.Type.IsUnknown() || permission.TargetID.IsUnknown() {
+			continue
+		}
+
+		needsTarget := sharePermissionNeedsTarget(permission.Type.ValueString())
+		if needsTarget && permission.TargetID.IsNull() {
+			diags.AddAttributeError(
+				path.Root("share_permissions"),
+				"Missing share permission target",
+				fmt.Sprintf("The `%s` share permission type requires a `target_id`.", permission.Type.ValueString()),
+			)
+		}
+		if !needsTarget && !permission.TargetID.IsNull() {
+			diags.AddAttributeError(
+				path.Root("share_permissions"),
+				"Unexpected share permission target",
+				fmt.Sprintf("The `%s` share permission type doesn't take a `target_id`.", permission.Type.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// sharePermissionNeedsTarget reports whether a share permission of the given type is granted to a specific project, group or user.
+func sharePermissionNeedsTarget(permissionType string) bool {
+	return permissionType == sharePermissionProject || permissionType == sharePermissionGroup || permissionType == sharePermissionUser
+}
+
+// sharePermissionsFromModel builds the Jira representation of the share permissions out of the model.
+// No share permission gives an empty list rather than nil, as Jira requires the list.
+func sharePermissionsFromModel(ctx context.Context, sharePermissions types.Set) ([]sharePermission, diag.Diagnostics) {
+	var permissions []JiraSharePermissionModel
+	diags := sharePermissions.ElementsAs(ctx, &permissions, false)
+
+	result := make([]sharePermission, 0, len(permissions))
+	for _, permission := range permissions {
+		jiraPermission := sharePermission{Type: permission.Type.ValueString()}
+		targetID := permission.TargetID.ValueString()
+
+		switch jiraPermission.Type {
+		case sharePermissionProject:
+			jiraPermission.Project = &sharePermissionTarget{ID: targetID}
+		case sharePermissionGroup:
+			jiraPermission.Group = &sharePermissionTarget{GroupID: targetID}
+		case sharePermissionUser:
+			jiraPermission.User = &sharePermissionTarget{AccountID: targetID}
+		}
+
+		result = append(result, jiraPermission)
+	}
+
+	return result, diags
+}
+
+// sharePermissionsValue converts the share permissions returned by Jira into a share_permissions set.
+// The permissions are reduced to their type and target, dropping the IDs Jira assigns to them,
+// so that they compare equal to the configured ones. No share permission gives a null set,
+// unless the current value is an empty set, which is kept so that configuring no share permission as `[]` applies cleanly.
+func sharePermissionsValue(ctx context.Context, jiraPermissions []sharePermission, current types.Set) (types.Set, diag.Diagnostics) {
+	if len(jiraPermissions) == 0 {
+		if isEmptySet(current) {
+			return current, nil
+		}
+
+		return types.SetNull(types.ObjectType{AttrTypes: sharePermissionAttrTypes}), nil
+	}
+
+	permissions := make([]JiraSharePermissionModel, 0, len(jiraPermissions))
+	for _, jiraPermission := range jiraPermissions {
+		targetID := ""
+		switch {
+		case jiraPermission.Project != nil:
+			targetID = jiraPermission.Project.ID
+		case jiraPermission.Group != nil:
+			targetID = jiraPermission.Group.GroupID
+		case jiraPermission.User != nil:
+			targetID = jiraPermission.User.AccountID
+		}
+
+		// Jira still answers with the former name of the authenticated type.
+		permissionType := jiraPermission.Type
+		if permissionType == "loggedin" {
+			permissionType = sharePermissionAuthenticated
+		}
+
+		permissions = append(permissions, JiraSharePermissionModel{
+			Type:     types.StringValue(permissionType),
+			TargetID: stringValueOrNull(targetID),
+		})
+	}
+
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: sharePermissionAttrTypes}, permissions)
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &JiraSprintDataSource{}
+	_ datasource.DataSourceWithConfigure      = &JiraSprintDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &JiraSprintDataSource{}
+)
+
+func NewJiraSprintDataSource() datasource.DataSource {
+	return &JiraSprintDataSource{}
+}
+
+// JiraSprintDataSource defines the data source implementation.
+type JiraSprintDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// sprintDetails is a single sprint of the Agile API.
+// Dates are kept as sent by Jira, and are missing for sprints that haven't started yet.
+type sprintDetails struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	Goal      string `json:"goal"`
+}
+
+// sprintsPage is a single page of the sprints of a board endpoint of the Agile API.
+type sprintsPage struct {
+	IsLast bool            `json:"isLast"`
+	Values []sprintDetails `json:"values"`
+}
+
+func (d *JiraSprintDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraSprintDataSourceModel struct {
+	BoardID   types.Int64  `tfsdk:"board_id"`
+	State     types.String `tfsdk:"state"`
+	Name      types.String `tfsdk:"name"`
+	ID        types.Int64  `tfsdk:"id"`
+	StartDate types.String `tfsdk:"start_date"`
+	EndDate   types.String `tfsdk:"end_date"`
+	Goal      types.String `tfsdk:"goal"`
+}
+
+func (d *JiraSprintDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sprint"
+}
+
+func (d *JiraSprintDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a sprint of a Jira Software board by state or by name, e.g. the active sprint of a board.",
+
+		Attributes: map[string]schema.Attribute{
+			"board_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the board, e.g. from the `jiracloud_board` data source.",
+				Required:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the sprint: `active`, `future` or `closed`. At least one of `state` and `name` must be set, " +
+					"and they must match exactly one sprint of the board.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "future", "closed"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The exact name of the sprint. At least one of `state` and `name` must be set, " +
+					"and they must match exactly one sprint of the board.",
+				Optional: true,
+				Computed: true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the sprint.",
+				Computed:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The start date of the sprint, in RFC 3339 format. Not set for sprints that weren't started yet.",
+				Computed:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "The planned end date of the sprint, in RFC 3339 format. Not set for sprints that weren't started yet.",
+				Computed:            true,
+			},
+			"goal": schema.StringAttribute{
+				MarkdownDescription: "The goal of the sprint.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JiraSprintDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config JiraSprintDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.State.IsUnknown() || config.Name.IsUnknown() {
+		return
+	}
+
+	if config.State.IsNull() && config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Missing sprint reference",
+			"At least one of `state` and `name` must be set.",
+		)
+	}
+}
+
+func (d *JiraSprintDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraSprintDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("maxResults", strconv.Itoa(agilePageSize))
+	if !state.State.IsNull() {
+		query.Set("state", state.State.ValueString())
+	}
+
+	var matches []sprintDetails
+	for startAt := 0; ; startAt += agilePageSize {
+		query.Set("startAt", strconv.Itoa(startAt))
+
+		page := new(sprintsPage)
+		apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/sprint?%s", state.BoardID.ValueInt64(), query.Encode())
+		_, err := doJiraRequest(ctx, d.client, http.MethodGet, apiEndpoint, nil, page)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read sprints",
+				fmt.Sprintf("An unexpected error occurred while reading the sprints of board %d... ", state.BoardID.ValueInt64())+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+
+		for _, sprint := range page.Values {
+			if state.Name.IsNull() || sprint.Name == state.Name.ValueString() {
+				matches = append(matches, sprint)
+			}
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	if len(matches) != 1 {
+		names := make([]string, 0, len(matches))
+		for _, sprint := range matches {
+			names = append(names, sprint.Name)
+		}
+
+		detail := fmt.Sprintf("Found %d matching sprints on board %d, expected exactly one.", len(matches), state.BoardID.ValueInt64())
+		if len(matches) > 1 {
+			detail += " Set `name` to pick one of: " + strings.Join(names, ", ")
+		}
+
+		resp.Diagnostics.AddError("Failed to find sprint", detail)
+		return
+	}
+
+	sprint := matches[0]
+	state.ID = types.Int64Value(sprint.ID)
+	state.Name = types.StringValue(sprint.Name)
+	state.State = types.StringValue(sprint.State)
+	state.StartDate = stringValueOrNull(sprint.StartDate)
+	state.EndDate = stringValueOrNull(sprint.EndDate)
+	state.Goal = types.StringValue(sprint.Goal)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
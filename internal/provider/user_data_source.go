@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &JiraUserDataSource{}
+	_ datasource.DataSourceWithConfigure      = &JiraUserDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &JiraUserDataSource{}
+)
+
+func NewJiraUserDataSource() datasource.DataSource {
+	return &JiraUserDataSource{}
+}
+
+// JiraUserDataSource defines the data source implementation.
+type JiraUserDataSource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+func (d *JiraUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraUserDataSourceModel struct {
+	Email        types.String `tfsdk:"email"`
+	AccountID    types.String `tfsdk:"account_id"`
+	DisplayName  types.String `tfsdk:"display_name"`
+	EmailAddress types.String `tfsdk:"email_address"`
+	Active       types.Bool   `tfsdk:"active"`
+	TimeZone     types.String `tfsdk:"time_zone"`
+}
+
+func (d *JiraUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *JiraUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a Jira user by email address or account ID, " +
+			"e.g. to turn an email address into the account ID expected by other resources.",
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user to look up. Exactly one of `email` and `account_id` must be set.",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The Jira account ID of the user. Exactly one of `email` and `account_id` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					isAccountID(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the user.",
+				Computed:            true,
+			},
+			"email_address": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user. Empty if the privacy settings of the user hide it.",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user account is active.",
+				Computed:            true,
+			},
+			"time_zone": schema.StringAttribute{
+				MarkdownDescription: "The time zone of the user, e.g. `Europe/Berlin`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *JiraUserDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config JiraUserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Email.IsUnknown() || config.AccountID.IsUnknown() {
+		return
+	}
+
+	if config.Email.IsNull() == config.AccountID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Invalid user reference",
+			"Exactly one of `email` and `account_id` must be set.",
+		)
+	}
+}
+
+func (d *JiraUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, d.requestTimeout)
+	defer cancel()
+
+	var state JiraUserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *jira.User
+	if !state.AccountID.IsNull() {
+		user = new(jira.User)
+		apiEndpoint := "rest/api/3/user?accountId=" + url.QueryEscape(state.AccountID.ValueString())
+		_, err := doJiraRequest(ctx, d.client, http.MethodGet, apiEndpoint, nil, user)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read user",
+				fmt.Sprintf("An unexpected error occurred while reading the user %s... ", state.AccountID.ValueString())+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+	} else {
+		email := state.Email.ValueString()
+		users, err := d.findUsersByEmail(ctx, email)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to search users",
+				fmt.Sprintf("An unexpected error occurred while searching the user %s... ", email)+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+
+		switch len(users) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"Failed to find user",
+				fmt.Sprintf("Could not find a user with the email address %s. ", email)+
+					"Users whose privacy settings hide their email address can only be looked up by account ID.",
+			)
+			return
+		case 1:
+			user = &users[0]
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"Ambiguous email address",
+				fmt.Sprintf("Found %d users with the email address %s, use `account_id` instead.", len(users), email),
+			)
+			return
+		}
+	}
+
+	state.AccountID = types.StringValue(user.AccountID)
+	state.DisplayName = types.StringValue(user.DisplayName)
+	state.EmailAddress = types.StringValue(user.EmailAddress)
+	state.Active = types.BoolValue(user.Active)
+	state.TimeZone = types.StringValue(user.TimeZone)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findUsersByEmail searches the users and returns the ones whose email address matches exactly.
+// The search also matches display names and partial addresses, hence the exact comparison.
+func (d *JiraUserDataSource) findUsersByEmail(ctx context.Context, email string) ([]jira.User, error) {
+	var users []jira.User
+	_, err := doJiraRequest(ctx, d.client, http.MethodGet, "rest/api/3/user/search?query="+url.QueryEscape(email), nil, &users)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []jira.User
+	for _, user := range users {
+		if strings.EqualFold(user.EmailAddress, email) {
+			matches = append(matches, user)
+		}
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserDataSource_ByEmail(t *testing.T) {
+	providerData, fake, _ := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newDataSourceHarness(t, NewJiraUserDataSource(), providerData)
+	jane := fake.addUser("557058:jane", "jane@example.com", "Jane Doe")
+	fake.addUser("557058:janet", "janet@example.com", "Janet Doe")
+	fake.addUser("557058:shared-1", "team@example.com", "Team")
+	fake.addUser("557058:shared-2", "team@example.com", "Team")
+
+	lookup := func(email string) JiraUserDataSourceModel {
+		return JiraUserDataSourceModel{
+			Email:        types.StringValue(email),
+			AccountID:    types.StringNull(),
+			DisplayName:  types.StringNull(),
+			EmailAddress: types.StringNull(),
+			Active:       types.BoolNull(),
+			TimeZone:     types.StringNull(),
+		}
+	}
+
+	// The search also matches janet@example.com, but only the exact address is taken.
+	var user JiraUserDataSourceModel
+	h.read(lookup("JANE@example.com"), &user)
+	if user.AccountID.ValueString() != jane.AccountID || user.DisplayName.ValueString() != jane.DisplayName {
+		t.Errorf("expected the user %s, got: %+v", jane.AccountID, user)
+	}
+
+	for email, wantSummary := range map[string]string{
+		"nobody@example.com": "Failed to find user",
+		"team@example.com":   "Ambiguous email address",
+	} {
+		diags := h.tryRead(lookup(email), &user)
+		if !diags.HasError() || diags[0].Summary() != wantSummary {
+			t.Errorf("%s: expected the error %q, got: %v", email, wantSummary, diags)
+		}
+	}
+}
@@ -0,0 +1,507 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// versionDateLayout is the ISO-8601 date format Jira uses for version start and release dates.
+const versionDateLayout = "2006-01-02"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &VersionResource{}
+	_ resource.ResourceWithConfigure      = &VersionResource{}
+	_ resource.ResourceWithImportState    = &VersionResource{}
+	_ resource.ResourceWithValidateConfig = &VersionResource{}
+	_ resource.ResourceWithModifyPlan     = &VersionResource{}
+)
+
+func NewVersionResource() resource.Resource {
+	return &VersionResource{}
+}
+
+// VersionResource defines the resource implementation.
+type VersionResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+	projects       *projectCache
+}
+
+// versionUpdateOptions is the payload accepted by the version update endpoint.
+// Unlike jira.Version, it sends dates that were removed from the configuration as null, which clears them in Jira.
+type versionUpdateOptions struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Released    bool    `json:"released"`
+	Archived    bool    `json:"archived"`
+	StartDate   *string `json:"startDate"`
+	ReleaseDate *string `json:"releaseDate"`
+}
+
+// versionRemoveAndSwapOptions is the payload accepted by the version remove and swap endpoint.
+type versionRemoveAndSwapOptions struct {
+	MoveFixIssuesTo      string `json:"moveFixIssuesTo,omitempty"`
+	MoveAffectedIssuesTo string `json:"moveAffectedIssuesTo,omitempty"`
+}
+
+func (r *VersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+	r.projects = providerData.Projects
+}
+
+type JiraVersionResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectKey           types.String `tfsdk:"project_key"`
+	ProjectID            types.String `tfsdk:"project_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Released             types.Bool   `tfsdk:"released"`
+	Archived             types.Bool   `tfsdk:"archived"`
+	StartDate            types.String `tfsdk:"start_date"`
+	ReleaseDate          types.String `tfsdk:"release_date"`
+	MoveFixIssuesTo      types.String `tfsdk:"move_fix_issues_to"`
+	MoveAffectedIssuesTo types.String `tfsdk:"move_affected_issues_to"`
+}
+
+func (r *VersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+func (r *VersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Version Resource, used for fix and release versions of a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira version.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira project that the version belongs to. " +
+					"Exactly one of `project_key` and `project_id` must be set. " +
+					"Changing the key only replaces the version if it resolves to another project, so renaming the project key is safe.",
+				Optional: true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira project that the version belongs to. " +
+					"Exactly one of `project_key` and `project_id` must be set. Resolved from `project_key` when not set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira version.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Jira version.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"released": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Jira version is released. A released version requires a `release_date`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Jira version is archived.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The start date of the Jira version in the ISO-8601 format `YYYY-MM-DD`.",
+				Optional:            true,
+			},
+			"release_date": schema.StringAttribute{
+				MarkdownDescription: "The release date of the Jira version in the ISO-8601 format `YYYY-MM-DD`.",
+				Optional:            true,
+			},
+			"move_fix_issues_to": schema.StringAttribute{
+				MarkdownDescription: "The ID of the version to move the issues fixed in this version to when it is deleted. " +
+					"If not set, the issues lose their fix version.",
+				Optional: true,
+			},
+			"move_affected_issues_to": schema.StringAttribute{
+				MarkdownDescription: "The ID of the version to move the issues affected by this version to when it is deleted. " +
+					"If not set, the issues lose their affected version.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *VersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config JiraVersionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attributeName, value := range map[string]types.String{"start_date": config.StartDate, "release_date": config.ReleaseDate} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if _, err := time.Parse(versionDateLayout, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Invalid version date",
+				fmt.Sprintf("The value %q is not an ISO-8601 date. Dates must be in the format `YYYY-MM-DD`.", value.ValueString()),
+			)
+		}
+	}
+
+	if !config.ProjectKey.IsUnknown() && !config.ProjectID.IsUnknown() && config.ProjectKey.IsNull() == config.ProjectID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_key"),
+			"Invalid project reference",
+			"Exactly one of `project_key` and `project_id` must be set.",
+		)
+	}
+
+	if config.Released.ValueBool() && config.ReleaseDate.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("release_date"),
+			"Missing version release date",
+			"Jira requires a release date for released versions. Set `release_date` when `released` is true.",
+		)
+	}
+}
+
+func (r *VersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraVersionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The versions API only accepts the numeric project ID, while users mostly know the project key.
+	if state.ProjectID.IsUnknown() || state.ProjectID.IsNull() {
+		projectID, err := r.resolveProjectID(ctx, state.ProjectKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to read %s project", state.ProjectKey.ValueString()),
+				fmt.Sprintf("An unexpected error occurred while reading the %s project... ", state.ProjectKey.ValueString())+
+					"Jira Cloud client error: "+jiraErrorDetail(err),
+			)
+			return
+		}
+
+		state.ProjectID = types.StringValue(projectID)
+	}
+
+	projectID, err := strconv.Atoi(state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_id"),
+			"Invalid project ID",
+			fmt.Sprintf("The project ID %q is not numeric.", state.ProjectID.ValueString()),
+		)
+		return
+	}
+
+	version := r.versionFromModel(&state)
+	version.ProjectID = projectID
+
+	newVersion, _, err := r.client.Version.Create(ctx, version)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create version",
+			fmt.Sprintf("An unexpected error occurred while creating a new version named %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	setVersionState(&state, newVersion)
+
+	tflog.Trace(ctx, "Created version", map[string]interface{}{"version_id": newVersion.ID, "project_id": state.ProjectID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VersionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	// Nothing to do on creation and destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state JiraVersionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsNull() || plan.ProjectKey.IsUnknown() || plan.ProjectKey.Equal(state.ProjectKey) {
+		return
+	}
+
+	// A new key may just be the new name of the same project, in which case the version stays where it is.
+	projectID, err := r.resolveProjectID(ctx, plan.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to read %s project", plan.ProjectKey.ValueString()),
+			fmt.Sprintf("An unexpected error occurred while reading the %s project... ", plan.ProjectKey.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	if projectID != state.ProjectID.ValueString() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("project_key"))
+	}
+}
+
+func (r *VersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraVersionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := versionUpdateOptions{
+		Name:        state.Name.ValueString(),
+		Released:    state.Released.ValueBool(),
+		Archived:    state.Archived.ValueBool(),
+		StartDate:   state.StartDate.ValueStringPointer(),
+		ReleaseDate: state.ReleaseDate.ValueStringPointer(),
+	}
+	// The description is computed when it isn't configured, in which case Jira keeps it as it is.
+	if !state.Description.IsUnknown() {
+		options.Description = state.Description.ValueStringPointer()
+	}
+
+	updatedVersion := new(jira.Version)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPut, fmt.Sprintf("rest/api/3/version/%s", state.ID.ValueString()), options, updatedVersion)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update version",
+			fmt.Sprintf("An unexpected error occurred while updating the version %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	setVersionState(&state, updatedVersion)
+
+	tflog.Trace(ctx, "Updated version", map[string]interface{}{"version_id": updatedVersion.ID, "project_id": state.ProjectID.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraVersionResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionID, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid version ID",
+			fmt.Sprintf("The version ID %q stored in the state is not numeric.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	version, response, err := r.client.Version.Get(ctx, versionID)
+	if err != nil {
+		if isNotFound(response) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to read version",
+			fmt.Sprintf("An unexpected error occurred while reading the version %s... ", state.ID.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	setVersionState(&state, version)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraVersionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := versionRemoveAndSwapOptions{
+		MoveFixIssuesTo:      state.MoveFixIssuesTo.ValueString(),
+		MoveAffectedIssuesTo: state.MoveAffectedIssuesTo.ValueString(),
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/version/%s/removeAndSwap", state.ID.ValueString())
+	response, err := doJiraRequest(ctx, r.client, http.MethodPost, apiEndpoint, options, nil)
+	if err != nil {
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete version",
+			fmt.Sprintf("An unexpected error occurred while deleting the version %s... ", state.Name.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted version", map[string]interface{}{"version_id": state.ID.ValueString(), "project_id": state.ProjectID.ValueString()})
+}
+
+func (r *VersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	version, err := r.getVersion(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import version",
+			fmt.Sprintf("An unexpected error occurred while reading the version %s. Resource import ID must be the numeric ID of the version... ", req.ID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state := JiraVersionResourceModel{
+		ProjectKey:           types.StringNull(),
+		MoveFixIssuesTo:      types.StringNull(),
+		MoveAffectedIssuesTo: types.StringNull(),
+	}
+	setVersionState(&state, version)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// resolveProjectID returns the ID of the project with the given key.
+func (r *VersionResource) resolveProjectID(ctx context.Context, projectKey string) (string, error) {
+	project, err := r.projects.resolve(ctx, r.client, projectKey)
+	if err != nil {
+		return "", err
+	}
+
+	return project.ID, nil
+}
+
+// getVersion fetches a version by its ID as stored in the state.
+func (r *VersionResource) getVersion(ctx context.Context, id string) (*jira.Version, error) {
+	versionID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+
+	version, _, err := r.client.Version.Get(ctx, versionID)
+
+	return version, err
+}
+
+// versionFromModel builds the go-jira representation of the version out of the model.
+func (r *VersionResource) versionFromModel(state *JiraVersionResourceModel) *jira.Version {
+	released := state.Released.ValueBool()
+	archived := state.Archived.ValueBool()
+
+	return &jira.Version{
+		Name:        state.Name.ValueString(),
+		Description: state.Description.ValueString(),
+		Released:    &released,
+		Archived:    &archived,
+		StartDate:   state.StartDate.ValueString(),
+		ReleaseDate: state.ReleaseDate.ValueString(),
+	}
+}
+
+// setVersionState copies the attributes returned by Jira into the model.
+// The project key is kept as configured, since the version only knows the ID of its project.
+func setVersionState(state *JiraVersionResourceModel, version *jira.Version) {
+	state.ID = types.StringValue(version.ID)
+	state.ProjectID = types.StringValue(strconv.Itoa(version.ProjectID))
+	state.Name = types.StringValue(version.Name)
+	state.Description = types.StringValue(version.Description)
+	state.Released = types.BoolValue(version.Released != nil && *version.Released)
+	state.Archived = types.BoolValue(version.Archived != nil && *version.Archived)
+	state.StartDate = stringValueOrNull(version.StartDate)
+	state.ReleaseDate = stringValueOrNull(version.ReleaseDate)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testVersionPlan returns the plan of an unreleased version in the given project.
+func testVersionPlan(projectKey, projectID types.String) JiraVersionResourceModel {
+	return JiraVersionResourceModel{
+		ID:                   types.StringUnknown(),
+		ProjectKey:           projectKey,
+		ProjectID:            projectID,
+		Name:                 types.StringValue("1.0.0"),
+		Description:          types.StringValue("Created by Terraform"),
+		Released:             types.BoolValue(false),
+		Archived:             types.BoolValue(false),
+		StartDate:            types.StringValue("2024-01-01"),
+		ReleaseDate:          types.StringNull(),
+		MoveFixIssuesTo:      types.StringNull(),
+		MoveAffectedIssuesTo: types.StringNull(),
+	}
+}
+
+func TestVersionResource_ProjectKey(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewVersionResource(), providerData)
+	project := fake.project(projectKey)
+
+	// Create
+	state := h.create(testVersionPlan(types.StringValue(projectKey), types.StringUnknown()))
+
+	var created JiraVersionResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() == "" {
+		t.Fatal("expected the created version to have an ID")
+	}
+	if created.ProjectID.ValueString() != project.ID || created.ProjectKey.ValueString() != projectKey {
+		t.Errorf("expected the version to be created in the project %s with ID %s, got: %+v", projectKey, project.ID, created)
+	}
+
+	// Read
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the created version to be found")
+	}
+
+	var read JiraVersionResourceModel
+	h.get(state, &read)
+	if read != created {
+		t.Errorf("expected read to return the created version\ncreated: %+v\nread:    %+v", created, read)
+	}
+
+	// Update
+	plan := read
+	plan.Released = types.BoolValue(true)
+	plan.ReleaseDate = types.StringValue("2024-02-01")
+	if _, requiresReplace := h.modifyPlan(state, plan); len(requiresReplace) > 0 {
+		t.Errorf("expected releasing the version to be done in place, got replacement for: %v", requiresReplace)
+	}
+	state = h.update(state, plan)
+
+	var updated JiraVersionResourceModel
+	h.get(state, &updated)
+	if updated.ID != created.ID || !updated.Released.ValueBool() || updated.ReleaseDate.ValueString() != "2024-02-01" {
+		t.Errorf("unexpected updated version: %+v", updated)
+	}
+
+	// Moving the version to another project replaces it.
+	fake.addProject("OTHER", "Other project")
+	plan = updated
+	plan.ProjectKey = types.StringValue("OTHER")
+	if _, requiresReplace := h.modifyPlan(state, plan); !requiresReplace.Contains(path.Root("project_key")) {
+		t.Errorf("expected moving the version to another project to replace it, got replacement for: %v", requiresReplace)
+	}
+
+	// Delete
+	h.delete(state)
+
+	if _, found := h.read(state); found {
+		t.Error("expected the deleted version to be removed from the state")
+	}
+}
+
+func TestVersionResource_ProjectID(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewVersionResource(), providerData)
+	project := fake.project(projectKey)
+
+	state := h.create(testVersionPlan(types.StringNull(), types.StringValue(project.ID)))
+
+	var created JiraVersionResourceModel
+	h.get(state, &created)
+	if created.ProjectID.ValueString() != project.ID || !created.ProjectKey.IsNull() {
+		t.Errorf("expected the version to be created in the project with ID %s, got: %+v", project.ID, created)
+	}
+
+	// Import, which can't tell the project key the version was configured with.
+	var imported JiraVersionResourceModel
+	h.get(h.importState(created.ID.ValueString()), &imported)
+	if imported != created {
+		t.Errorf("expected import to return the created version\ncreated:  %+v\nimported: %+v", created, imported)
+	}
+
+	// Exactly one of project_key and project_id must be set.
+	config := testVersionPlan(types.StringValue(projectKey), types.StringValue(project.ID))
+	config.ID = types.StringNull()
+	if diags := h.validate(config); !diags.HasError() {
+		t.Error("expected setting both project_key and project_id to be invalid")
+	}
+}
+
+func TestVersionResource_ClearDates(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewVersionResource(), providerData)
+
+	state := h.create(testVersionPlan(types.StringValue(projectKey), types.StringUnknown()))
+
+	var created JiraVersionResourceModel
+	h.get(state, &created)
+
+	// Set
+	plan := created
+	plan.ReleaseDate = types.StringValue("2024-02-01")
+	state = h.update(state, plan)
+
+	var updated JiraVersionResourceModel
+	h.get(state, &updated)
+	if updated.ReleaseDate.ValueString() != "2024-02-01" {
+		t.Fatalf("expected the release date to be set, got: %s", updated.ReleaseDate)
+	}
+
+	// Clear
+	plan = updated
+	plan.StartDate = types.StringNull()
+	plan.ReleaseDate = types.StringNull()
+	state = h.update(state, plan)
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the version to be found")
+	}
+
+	var cleared JiraVersionResourceModel
+	h.get(state, &cleared)
+	if !cleared.StartDate.IsNull() || !cleared.ReleaseDate.IsNull() {
+		t.Errorf("expected the dates of the version to be cleared, got: %s and %s", cleared.StartDate, cleared.ReleaseDate)
+	}
+	if cleared.Description.ValueString() != "Created by Terraform" {
+		t.Errorf("expected the description to be kept, got: %s", cleared.Description)
+	}
+}
@@ -0,0 +1,457 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// webhooksPageSize is the number of webhooks requested per page.
+	webhooksPageSize = 100
+
+	// webhookRefreshWindow is how long before their expiration webhooks are refreshed.
+	// Jira lets dynamic webhooks expire 30 days after their registration or last refresh.
+	webhookRefreshWindow = 7 * 24 * time.Hour
+)
+
+// webhookEvents are the events dynamic webhooks can be registered for.
+var webhookEvents = []string{
+	"jira:issue_created",
+	"jira:issue_updated",
+	"jira:issue_deleted",
+	"comment_created",
+	"comment_updated",
+	"comment_deleted",
+	"issue_property_set",
+	"issue_property_deleted",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &WebhookResource{}
+	_ resource.ResourceWithConfigure  = &WebhookResource{}
+	_ resource.ResourceWithModifyPlan = &WebhookResource{}
+)
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+// WebhookResource defines the resource implementation.
+type WebhookResource struct {
+	client         *jira.Client
+	requestTimeout time.Duration
+}
+
+// webhookRegistration is the payload accepted by the webhook registration endpoint.
+type webhookRegistration struct {
+	URL      string           `json:"url"`
+	Webhooks []webhookRequest `json:"webhooks"`
+}
+
+// webhookRequest is a single webhook to register.
+type webhookRequest struct {
+	Events []string `json:"events"`
+	JQL    string   `json:"jqlFilter"`
+}
+
+// webhookRegistrationResult is the answer of the webhook registration endpoint, with one result per webhook registered.
+type webhookRegistrationResult struct {
+	Results []struct {
+		CreatedWebhookID int64    `json:"createdWebhookId"`
+		Errors           []string `json:"errors"`
+	} `json:"webhookRegistrationResult"`
+}
+
+// webhookDetails is a single entry returned by the webhook list endpoint. It lacks the URL, which is the same for all
+// the webhooks registered by an app. The expiration date is in milliseconds since the epoch.
+type webhookDetails struct {
+	ID             int64    `json:"id"`
+	JQL            string   `json:"jqlFilter"`
+	Events         []string `json:"events"`
+	ExpirationDate int64    `json:"expirationDate"`
+}
+
+// webhooksPage is a single page of the webhook list endpoint.
+type webhooksPage struct {
+	IsLast bool             `json:"isLast"`
+	Values []webhookDetails `json:"values"`
+}
+
+// webhookIDs is the payload accepted by the webhook refresh and delete endpoints.
+type webhookIDs struct {
+	WebhookIDs []int64 `json:"webhookIds"`
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraCloudProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.JiraCloudProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.requestTimeout = providerData.RequestTimeout
+}
+
+type JiraWebhookResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	URL            types.String `tfsdk:"url"`
+	JQLFilter      types.String `tfsdk:"jql_filter"`
+	Events         types.Set    `tfsdk:"events"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Jira Webhook Resource, registering a dynamic webhook, e.g. to notify an automation endpoint of new issues. " +
+			"Dynamic webhooks can only be registered by OAuth 2.0 and Connect apps, so the provider must use the `bearer` authentication method. " +
+			fmt.Sprintf("Jira lets them expire 30 days after their registration, so the webhook is refreshed by any apply run less than %d days before its expiration. ", int(webhookRefreshWindow.Hours()/24)) +
+			"A webhook found expired is registered again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira webhook.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL the webhook sends the events to. Jira only accepts URLs of the app registering the webhook.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"jql_filter": schema.StringAttribute{
+				MarkdownDescription: "The JQL query selecting the issues the webhook sends the events of, e.g. `project = ABC`. " +
+					"Jira only supports a subset of JQL for webhooks.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "The events the webhook is sent for, among `" + strings.Join(webhookEvents, "`, `") + "`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(webhookEvents...)),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				MarkdownDescription: "When the webhook expires unless refreshed, in the RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraWebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook := webhookRequest{JQL: state.JQLFilter.ValueString()}
+	resp.Diagnostics.Append(state.Events.ElementsAs(ctx, &webhook.Events, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registration := webhookRegistration{URL: state.URL.ValueString(), Webhooks: []webhookRequest{webhook}}
+
+	result := new(webhookRegistrationResult)
+	_, err := doJiraRequest(ctx, r.client, http.MethodPost, "rest/api/3/webhook", registration, result)
+	if err == nil && (len(result.Results) != 1 || len(result.Results[0].Errors) > 0) {
+		err = fmt.Errorf("the webhook was not registered: %s", webhookRegistrationErrors(result))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create webhook",
+			fmt.Sprintf("An unexpected error occurred while registering a new webhook for %s... ", state.URL.ValueString())+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	webhookID := result.Results[0].CreatedWebhookID
+
+	// The registration only answers with the ID of the webhook, so the expiration date is read back.
+	// The webhook is registered whatever happens then, so failing to read it back only leaves the expiration date
+	// to the next refresh rather than losing track of the webhook.
+	created, err := r.findWebhook(ctx, webhookID)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Failed to read webhook",
+			fmt.Sprintf("The webhook %d was registered, but an unexpected error occurred while reading it back. ", webhookID)+
+				"Its expiration date will be read on the next refresh. "+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+	}
+
+	state.ID = types.StringValue(strconv.FormatInt(webhookID, 10))
+	state.ExpirationDate = types.StringNull()
+	if created != nil {
+		state.ExpirationDate = webhookExpirationDate(created.ExpirationDate)
+	}
+
+	tflog.Trace(ctx, "Created webhook", map[string]interface{}{"webhook_id": webhookID, "expiration_date": state.ExpirationDate.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on creation and destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state JiraWebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Planning a new expiration date makes Terraform run the update, which refreshes the webhook.
+	expirationDate, err := time.Parse(time.RFC3339, state.ExpirationDate.ValueString())
+	if err != nil || time.Until(expirationDate) < webhookRefreshWindow {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expiration_date"), types.StringUnknown())...)
+	}
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraWebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid webhook ID",
+			fmt.Sprintf("The webhook ID %q stored in the state is not numeric.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	// Every other attribute forces a new webhook, so an update only ever refreshes the webhook.
+	refreshed := new(webhookDetails)
+	_, err = doJiraRequest(ctx, r.client, http.MethodPut, "rest/api/3/webhook/refresh", webhookIDs{WebhookIDs: []int64{webhookID}}, refreshed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to refresh webhook",
+			fmt.Sprintf("An unexpected error occurred while extending the expiration of the webhook %d... ", webhookID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	state.ExpirationDate = webhookExpirationDate(refreshed.ExpirationDate)
+
+	tflog.Trace(ctx, "Refreshed webhook", map[string]interface{}{"webhook_id": webhookID, "expiration_date": state.ExpirationDate.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraWebhookResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid webhook ID",
+			fmt.Sprintf("The webhook ID %q stored in the state is not numeric.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	webhook, err := r.findWebhook(ctx, webhookID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read webhook",
+			fmt.Sprintf("An unexpected error occurred while reading the webhook %d... ", webhookID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	// Jira forgets expired webhooks, so a missing webhook has most likely expired rather than been deleted.
+	if webhook == nil || time.UnixMilli(webhook.ExpirationDate).Before(time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"Webhook expired",
+			fmt.Sprintf("The webhook %d for %s is no longer registered in Jira, most likely because it expired. ", webhookID, state.URL.ValueString())+
+				"Jira lets dynamic webhooks expire 30 days after their registration or last refresh. "+
+				"The webhook will be registered again. Running an apply at least every few weeks refreshes webhooks before they expire.",
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.JQLFilter = types.StringValue(webhook.JQL)
+	state.ExpirationDate = webhookExpirationDate(webhook.ExpirationDate)
+
+	events, diags := types.SetValueFrom(ctx, types.StringType, webhook.Events)
+	resp.Diagnostics.Append(diags...)
+	state.Events = events
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withRequestTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	var state JiraWebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid webhook ID",
+			fmt.Sprintf("The webhook ID %q stored in the state is not numeric.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	response, err := doJiraRequest(ctx, r.client, http.MethodDelete, "rest/api/3/webhook", webhookIDs{WebhookIDs: []int64{webhookID}}, nil)
+	if err != nil {
+		// The webhook may have expired in the meantime.
+		if isNotFound(response) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete webhook",
+			fmt.Sprintf("An unexpected error occurred while deleting the webhook %d... ", webhookID)+
+				"Jira Cloud client error: "+jiraErrorDetail(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted webhook", map[string]interface{}{"webhook_id": webhookID})
+}
+
+// findWebhook returns the webhook with the given ID among the ones registered by the app, or nil.
+// Jira doesn't offer to get a single webhook, so the webhooks are paged through.
+func (r *WebhookResource) findWebhook(ctx context.Context, webhookID int64) (*webhookDetails, error) {
+	for startAt := 0; ; startAt += webhooksPageSize {
+		apiEndpoint := fmt.Sprintf("rest/api/3/webhook?startAt=%d&maxResults=%d", startAt, webhooksPageSize)
+
+		page := new(webhooksPage)
+		_, err := doJiraRequest(ctx, r.client, http.MethodGet, apiEndpoint, nil, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page.Values {
+			if page.Values[i].ID == webhookID {
+				return &page.Values[i], nil
+			}
+		}
+
+		if page.IsLast || len(page.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+// webhookRegistrationErrors joins the errors Jira reported for the registered webhooks.
+func webhookRegistrationErrors(result *webhookRegistrationResult) string {
+	var errors []string
+	for _, webhookResult := range result.Results {
+		errors = append(errors, webhookResult.Errors...)
+	}
+
+	if len(errors) == 0 {
+		return "Jira did not report the ID of the webhook"
+	}
+
+	return strings.Join(errors, "; ")
+}
+
+// webhookExpirationDate converts the expiration date of a webhook, in milliseconds since the epoch, to RFC 3339.
+func webhookExpirationDate(milliseconds int64) types.String {
+	if milliseconds == 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(time.UnixMilli(milliseconds).UTC().Format(time.RFC3339))
+}
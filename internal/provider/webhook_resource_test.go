@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWebhookResource_RefreshBeforeExpiration(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewWebhookResource(), providerData)
+
+	plan := JiraWebhookResourceModel{
+		ID:             types.StringUnknown(),
+		URL:            types.StringValue("https://automation.example.com/jira"),
+		JQLFilter:      types.StringValue("project = " + projectKey),
+		Events:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("jira:issue_created")}),
+		ExpirationDate: types.StringUnknown(),
+	}
+	state := h.create(plan)
+
+	var created JiraWebhookResourceModel
+	h.get(state, &created)
+	if created.ExpirationDate.IsNull() {
+		t.Fatal("expected the created webhook to have an expiration date")
+	}
+
+	// A fresh webhook is left alone.
+	modified, _ := h.modifyPlan(state, created)
+	var planned JiraWebhookResourceModel
+	if diags := modified.Get(context.Background(), &planned); diags.HasError() {
+		t.Fatalf("reading the plan: %v", diags)
+	}
+	if planned.ExpirationDate.IsUnknown() {
+		t.Error("expected no refresh to be planned for a fresh webhook")
+	}
+
+	// A webhook about to expire is refreshed by an update.
+	webhookID, _ := strconv.ParseInt(created.ID.ValueString(), 10, 64)
+	fake.setWebhookExpiration(webhookID, time.Now().Add(48*time.Hour))
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the webhook about to expire to be found")
+	}
+
+	var expiring JiraWebhookResourceModel
+	h.get(state, &expiring)
+
+	modified, requiresReplace := h.modifyPlan(state, expiring)
+	if len(requiresReplace) > 0 {
+		t.Errorf("expected the refresh not to replace the webhook, got replacement for: %v", requiresReplace)
+	}
+	if diags := modified.Get(context.Background(), &planned); diags.HasError() {
+		t.Fatalf("reading the plan: %v", diags)
+	}
+	if !planned.ExpirationDate.IsUnknown() {
+		t.Fatal("expected a refresh to be planned for a webhook about to expire")
+	}
+
+	state = h.update(state, planned)
+
+	var refreshed JiraWebhookResourceModel
+	h.get(state, &refreshed)
+	expirationDate, err := time.Parse(time.RFC3339, refreshed.ExpirationDate.ValueString())
+	if err != nil || time.Until(expirationDate) < 29*24*time.Hour {
+		t.Errorf("expected the refreshed webhook to expire in 30 days, got: %s", refreshed.ExpirationDate)
+	}
+
+	h.delete(state)
+}
+
+func TestWebhookResource_CreateKeepsWebhookWhenReadBackFails(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewWebhookResource(), providerData)
+
+	// Jira registers the webhook, then refuses to list the webhooks.
+	fake.handle(http.MethodGet, "/rest/api/3/webhook", func(w http.ResponseWriter, r *http.Request) {
+		writeJiraError(w, http.StatusForbidden, "You are not authorized to perform this action.")
+	})
+
+	state, diags := h.tryCreate(JiraWebhookResourceModel{
+		ID:             types.StringUnknown(),
+		URL:            types.StringValue("https://automation.example.com/jira"),
+		JQLFilter:      types.StringValue("project = " + projectKey),
+		Events:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("jira:issue_created")}),
+		ExpirationDate: types.StringUnknown(),
+	})
+	if diags.HasError() {
+		t.Fatalf("expected the creation to succeed with a warning, got: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning about the failed read back, got: %v", diags)
+	}
+
+	var created JiraWebhookResourceModel
+	h.get(state, &created)
+	if created.ID.IsNull() || created.ID.ValueString() == "" {
+		t.Fatal("expected the state to track the registered webhook")
+	}
+	if !created.ExpirationDate.IsNull() {
+		t.Errorf("expected the expiration date to be left to the next refresh, got: %s", created.ExpirationDate)
+	}
+
+	fake.mu.Lock()
+	delete(fake.handlers, http.MethodGet+" /rest/api/3/webhook")
+	fake.mu.Unlock()
+
+	state, found := h.read(state)
+	if !found {
+		t.Fatal("expected the registered webhook to be found")
+	}
+
+	var read JiraWebhookResourceModel
+	h.get(state, &read)
+	if read.ExpirationDate.IsNull() {
+		t.Error("expected the refresh to read the expiration date")
+	}
+}
+
+func TestWebhookResource_ReadExpired(t *testing.T) {
+	providerData, fake, projectKey := testJira(t)
+	if fake == nil {
+		t.Skip("needs the fake Jira")
+	}
+
+	h := newResourceHarness(t, NewWebhookResource(), providerData)
+
+	state := h.create(JiraWebhookResourceModel{
+		ID:             types.StringUnknown(),
+		URL:            types.StringValue("https://automation.example.com/jira"),
+		JQLFilter:      types.StringValue("project = " + projectKey),
+		Events:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("comment_created")}),
+		ExpirationDate: types.StringUnknown(),
+	})
+
+	var created JiraWebhookResourceModel
+	h.get(state, &created)
+	webhookID, _ := strconv.ParseInt(created.ID.ValueString(), 10, 64)
+
+	for name, expire := range map[string]func(){
+		"expired":   func() { fake.setWebhookExpiration(webhookID, time.Now().Add(-time.Hour)) },
+		"forgotten": func() { fake.forgetWebhook(webhookID) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			expire()
+
+			resp := resource.ReadResponse{State: state}
+			h.resource.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+			if !resp.State.Raw.IsNull() {
+				t.Error("expected the expired webhook to be removed from the state")
+			}
+			if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 ||
+				!strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), "expire") {
+				t.Errorf("expected a single warning explaining the expiration, got: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWebhookResource_UnknownEvent(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := resource.SchemaResponse{}
+	NewWebhookResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	events := schemaResp.Schema.Attributes["events"].(schema.SetAttribute)
+
+	for _, test := range []struct {
+		event   string
+		wantErr bool
+	}{
+		{"jira:issue_created", false},
+		{"comment_deleted", false},
+		{"jira:issue_craeted", true},
+		{"issue_created", true},
+	} {
+		req := validator.SetRequest{
+			Path:        path.Root("events"),
+			ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(test.event)}),
+		}
+
+		var diags diag.Diagnostics
+		for _, setValidator := range events.Validators {
+			resp := validator.SetResponse{}
+			setValidator.ValidateSet(ctx, req, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+
+		if diags.HasError() != test.wantErr {
+			t.Errorf("event %q: expected an error %v, got: %v", test.event, test.wantErr, diags)
+		}
+		if test.wantErr && diags.HasError() && !strings.Contains(diags.Errors()[0].Detail(), "jira:issue_created") {
+			t.Errorf("event %q: expected the error to list the valid events, got: %s", test.event, diags.Errors()[0].Detail())
+		}
+	}
+}